@@ -0,0 +1,75 @@
+package files
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/artifacts/remote"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/exec"
+)
+
+type build struct {
+	name string
+}
+
+func Build(name string) bundle.Mutator {
+	return &build{
+		name: name,
+	}
+}
+
+func (m *build) Name() string {
+	return fmt.Sprintf("files.Build(%s)", m.name)
+}
+
+func (m *build) Apply(ctx context.Context, b *bundle.Bundle) error {
+	a, ok := b.Config.Artifacts[m.name]
+	if !ok {
+		return fmt.Errorf("artifact doesn't exist: %s", m.name)
+	}
+
+	artifact := a.Files
+	if artifact.Files == "" {
+		return fmt.Errorf("no files pattern specified for artifact %s", m.name)
+	}
+
+	dir := filepath.Join(b.Config.Path, artifact.Path)
+	if info, err := os.Stat(dir); err != nil || !info.IsDir() {
+		return fmt.Errorf("artifact directory not found: %s", artifact.Path)
+	}
+
+	if artifact.Build != "" {
+		cmdio.LogString(ctx, fmt.Sprintf("Building %s...", m.name))
+		err := exec.Run(ctx, dir, artifact.Build)
+		if err != nil {
+			return fmt.Errorf("unable to build %s: %w", m.name, err)
+		}
+	}
+
+	matches, err := filepath.Glob(filepath.Join(dir, filepath.FromSlash(artifact.Files)))
+	if err != nil {
+		return fmt.Errorf("invalid files pattern for artifact %s: %w", m.name, err)
+	}
+	if len(matches) == 0 {
+		return fmt.Errorf("no files match %s for artifact %s", artifact.Files, m.name)
+	}
+
+	remotePaths, err := remote.RemotePaths(b, m.name, matches)
+	if err != nil {
+		return err
+	}
+
+	artifact.LocalPaths = matches
+	artifact.RemotePaths = remotePaths
+	if len(artifact.RemotePaths) == 1 {
+		a.RemotePath = artifact.RemotePaths[0]
+	} else {
+		a.RemotePath = path.Dir(artifact.RemotePaths[0])
+	}
+	return nil
+}
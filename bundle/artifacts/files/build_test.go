@@ -0,0 +1,82 @@
+package files
+
+import (
+	"os"
+	"path"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/cli/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testBundle(t *testing.T, artifact *config.FilesArtifact) *bundle.Bundle {
+	dir := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "app"), 0755))
+	return &bundle.Bundle{
+		Config: config.Root{
+			Path: dir,
+			Bundle: config.Bundle{
+				Environment: "default",
+			},
+			Workspace: config.Workspace{
+				ArtifactsPath: "/Users/jane@doe.com/.bundle/artifacts",
+			},
+			Artifacts: map[string]*config.Artifact{
+				"app": {
+					Files: artifact,
+				},
+			},
+		},
+	}
+}
+
+func TestBuildSingleFile(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("build command uses a POSIX shell")
+	}
+
+	b := testBundle(t, &config.FilesArtifact{
+		Path:  "app",
+		Build: "mkdir target && touch target/app.jar target/app.txt",
+		Files: "target/*.jar",
+	})
+	err := bundle.Apply(testutil.Context(), b, Build("app"))
+	require.NoError(t, err)
+
+	a := b.Config.Artifacts["app"]
+	assert.Equal(t, []string{filepath.Join(b.Config.Path, "app", "target", "app.jar")}, a.Files.LocalPaths)
+	assert.Regexp(t, "^/Workspace/Users/jane@doe.com/.bundle/artifacts/app/[0-9a-f]{64}/app.jar$", a.RemotePath)
+}
+
+func TestBuildMultipleFiles(t *testing.T) {
+	b := testBundle(t, &config.FilesArtifact{
+		Path:  "app",
+		Files: "*.sql",
+	})
+	for _, name := range []string{"a.sql", "b.sql"} {
+		require.NoError(t, os.WriteFile(filepath.Join(b.Config.Path, "app", name), nil, 0644))
+	}
+
+	err := bundle.Apply(testutil.Context(), b, Build("app"))
+	require.NoError(t, err)
+
+	a := b.Config.Artifacts["app"]
+	dir := path.Dir(a.Files.RemotePaths[0])
+	assert.Regexp(t, "^/Workspace/Users/jane@doe.com/.bundle/artifacts/app/[0-9a-f]{64}$", dir)
+	assert.Equal(t, []string{dir + "/a.sql", dir + "/b.sql"}, a.Files.RemotePaths)
+	assert.Equal(t, dir, a.RemotePath)
+}
+
+func TestBuildNoMatches(t *testing.T) {
+	b := testBundle(t, &config.FilesArtifact{
+		Path:  "app",
+		Files: "*.jar",
+	})
+	err := bundle.Apply(testutil.Context(), b, Build("app"))
+	assert.ErrorContains(t, err, "no files match *.jar for artifact app")
+}
@@ -0,0 +1,43 @@
+package files
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/artifacts/remote"
+)
+
+type upload struct {
+	name string
+}
+
+func Upload(name string) bundle.Mutator {
+	return &upload{
+		name: name,
+	}
+}
+
+func (m *upload) Name() string {
+	return fmt.Sprintf("files.Upload(%s)", m.name)
+}
+
+func (m *upload) Apply(ctx context.Context, b *bundle.Bundle) error {
+	a, ok := b.Config.Artifacts[m.name]
+	if !ok {
+		return fmt.Errorf("artifact doesn't exist: %s", m.name)
+	}
+
+	artifact := a.Files
+	if len(artifact.LocalPaths) == 0 {
+		return fmt.Errorf("artifact %s has not been built", m.name)
+	}
+
+	for i, localPath := range artifact.LocalPaths {
+		err := remote.UploadFile(ctx, b, localPath, artifact.RemotePaths[i])
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package remote
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/databricks/cli/bundle"
+)
+
+// CacheFileName is the name of the file in the bundle cache directory
+// that records the content hashes of uploaded artifact files.
+const CacheFileName = "artifacts.json"
+
+type uploadCache struct {
+	path string
+
+	// Uploads maps the remote path of an uploaded file to the hash of its contents.
+	Uploads map[string]string `json:"uploads"`
+}
+
+// loadUploadCache reads the upload cache from the bundle cache directory.
+// It returns an empty cache if none has been written yet.
+func loadUploadCache(b *bundle.Bundle) (*uploadCache, error) {
+	dir, err := b.CacheDir()
+	if err != nil {
+		return nil, err
+	}
+
+	c := &uploadCache{
+		path:    filepath.Join(dir, CacheFileName),
+		Uploads: make(map[string]string),
+	}
+
+	buf, err := os.ReadFile(c.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return c, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(buf, c)
+	if err != nil {
+		return nil, err
+	}
+	if c.Uploads == nil {
+		c.Uploads = make(map[string]string)
+	}
+	return c, nil
+}
+
+// ClearUploadCache removes the record of uploaded artifact files.
+// It must be called when the remote artifact files are deleted.
+func ClearUploadCache(b *bundle.Bundle) error {
+	dir, err := b.CacheDir()
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(filepath.Join(dir, CacheFileName))
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+func (c *uploadCache) save() error {
+	buf, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(c.path, buf, 0600)
+}
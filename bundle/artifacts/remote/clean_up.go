@@ -0,0 +1,108 @@
+package remote
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"strings"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/deploy/history"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/filer"
+	"github.com/databricks/cli/libs/log"
+)
+
+type cleanUp struct{}
+
+func (m *cleanUp) Name() string {
+	return "remote.CleanUp"
+}
+
+func (m *cleanUp) Apply(ctx context.Context, b *bundle.Bundle) error {
+	f, err := filer.NewWorkspaceFilesClient(b.WorkspaceClient(), b.Config.Workspace.StatePath)
+	if err != nil {
+		return err
+	}
+
+	h, err := history.Load(ctx, f)
+	if err != nil {
+		return err
+	}
+
+	// Directories referenced by the current and the previous deployment are retained,
+	// such that job runs started by the previous deployment can complete.
+	keep := make(map[string]bool)
+	for _, a := range b.Config.Artifacts {
+		if dir := a.RemoteDir(); dir != "" {
+			keep[dir] = true
+		}
+	}
+
+	previous := h.LastDeployment()
+	if previous != nil && len(previous.Artifacts) == 0 {
+		// Deployments recorded before artifacts were recorded may refer to any of them.
+		log.Infof(ctx, "Skipping artifact clean up; previous deployment did not record its artifacts")
+		return nil
+	}
+
+	// Clean up the parent directories of all artifacts, including those
+	// that were removed from the configuration since the previous deployment.
+	parents := make(map[string]bool)
+	for dir := range keep {
+		parents[path.Dir(dir)] = true
+	}
+	if previous != nil {
+		for _, dir := range previous.Artifacts {
+			keep[dir] = true
+			parents[path.Dir(dir)] = true
+		}
+	}
+
+	for parent := range parents {
+		client, err := filer.NewWorkspaceFilesClient(b.WorkspaceClient(), strings.TrimPrefix(parent, "/Workspace"))
+		if err != nil {
+			return err
+		}
+		err = removeStaleDirs(ctx, client, parent, keep)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// removeStaleDirs removes the content hash directories of an artifact that
+// are not retained. The filer is rooted at the parent directory, which is
+// the directory of the artifact under the artifact path.
+func removeStaleDirs(ctx context.Context, f filer.Filer, parent string, keep map[string]bool) error {
+	entries, err := f.ReadDir(ctx, ".")
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		if !entry.IsDir() || keep[path.Join(parent, entry.Name())] {
+			continue
+		}
+
+		cmdio.LogString(ctx, fmt.Sprintf("Removing stale artifact files at %s", path.Join(parent, entry.Name())))
+		err := f.Delete(ctx, entry.Name(), filer.DeleteRecursively)
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+	}
+	return nil
+}
+
+// CleanUp returns a [bundle.Mutator] that removes uploaded artifact files that
+// are referenced by neither the current nor the previous deployment.
+// It must run before the current deployment is recorded in the deployment history.
+func CleanUp() bundle.Mutator {
+	return &cleanUp{}
+}
@@ -0,0 +1,42 @@
+package remote
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/databricks/cli/internal/testutil"
+	"github.com/databricks/cli/libs/filer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemoveStaleDirs(t *testing.T) {
+	dir := t.TempDir()
+	for _, hash := range []string{"current", "previous", "stale"} {
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, hash), 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, hash, "app.jar"), nil, 0644))
+	}
+
+	f, err := filer.NewLocalClient(dir)
+	require.NoError(t, err)
+
+	parent := "/Workspace/Users/jane@doe.com/.bundle/artifacts/app"
+	err = removeStaleDirs(testutil.Context(), f, parent, map[string]bool{
+		parent + "/current":  true,
+		parent + "/previous": true,
+	})
+	require.NoError(t, err)
+
+	assert.DirExists(t, filepath.Join(dir, "current"))
+	assert.DirExists(t, filepath.Join(dir, "previous"))
+	assert.NoDirExists(t, filepath.Join(dir, "stale"))
+}
+
+func TestRemoveStaleDirsWithoutParent(t *testing.T) {
+	f, err := filer.NewLocalClient(filepath.Join(t.TempDir(), "app"))
+	require.NoError(t, err)
+
+	err = removeStaleDirs(testutil.Context(), f, "/Users/jane@doe.com/.bundle/artifacts/app", nil)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,150 @@
+// Package remote determines the remote paths of artifact files and uploads them.
+// It is shared by all artifact types that upload local files to the workspace.
+package remote
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/filer"
+	"github.com/databricks/cli/libs/log"
+)
+
+// hashFile returns the hex encoded SHA-256 hash of the contents of a file.
+func hashFile(localPath string) (string, error) {
+	f, err := os.Open(localPath)
+	if err != nil {
+		return "", fmt.Errorf("unable to open %s: %w", localPath, errors.Unwrap(err))
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	_, err = io.Copy(h, f)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// hashFiles returns the hex encoded SHA-256 hash of the names and contents of files.
+func hashFiles(localPaths []string) (string, error) {
+	h := sha256.New()
+	for _, localPath := range localPaths {
+		hash, err := hashFile(localPath)
+		if err != nil {
+			return "", err
+		}
+		fmt.Fprintf(h, "%s\x00%s\x00", filepath.Base(localPath), hash)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// RemotePaths returns the paths that job tasks use to refer to
+// the uploaded copies of the local files of the specified artifact.
+//
+// The files are uploaded to a directory named after their combined content hash
+// such that a path always refers to the same contents and running jobs are not
+// affected by later deployments. The hash is a directory component so that
+// file names are preserved, because pip requires wheel file names to follow
+// a naming convention. Directories that are referenced by neither the current
+// nor the previous deployment are removed by [CleanUp].
+func RemotePaths(b *bundle.Bundle, name string, localPaths []string) ([]string, error) {
+	artifactPath := b.Config.Workspace.ArtifactsPath
+	if artifactPath == "" {
+		return nil, fmt.Errorf("remote artifact path not configured")
+	}
+
+	hash, err := hashFiles(localPaths)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []string
+	seen := make(map[string]string)
+	for _, localPath := range localPaths {
+		base := filepath.Base(localPath)
+		if other, ok := seen[base]; ok {
+			return nil, fmt.Errorf("%s and %s of artifact %s have the same file name", other, localPath, name)
+		}
+		seen[base] = localPath
+		out = append(out, libraryPath(path.Join(artifactPath, name, hash, base)))
+	}
+	return out, nil
+}
+
+// libraryPath returns the path that job task libraries use to refer
+// to a file in the workspace. Paths without the "/Workspace" prefix
+// are interpreted as DBFS paths.
+func libraryPath(p string) string {
+	if strings.HasPrefix(p, "/Workspace/") {
+		return p
+	}
+	return path.Join("/Workspace", p)
+}
+
+// UploadFile uploads a local file to a path returned by [RemotePaths].
+// The upload is skipped if a file with the same contents has been uploaded
+// to the same path before, as recorded in the bundle cache directory,
+// and the remote file still exists.
+func UploadFile(ctx context.Context, b *bundle.Bundle, localPath, remotePath string) error {
+	dir := strings.TrimPrefix(path.Dir(remotePath), "/Workspace")
+	client, err := filer.NewWorkspaceFilesClient(b.WorkspaceClient(), dir)
+	if err != nil {
+		return err
+	}
+
+	return uploadFile(ctx, b, client, localPath, remotePath)
+}
+
+// uploadFile uploads a local file to the specified filer, which is rooted
+// at the directory of the remote path.
+func uploadFile(ctx context.Context, b *bundle.Bundle, client filer.Filer, localPath, remotePath string) error {
+	hash, err := hashFile(localPath)
+	if err != nil {
+		return err
+	}
+
+	cache, err := loadUploadCache(b)
+	if err != nil {
+		return err
+	}
+
+	name := path.Base(remotePath)
+	if cache.Uploads[remotePath] == hash {
+		_, err := client.Stat(ctx, name)
+		if err == nil {
+			log.Infof(ctx, "Skipping upload of %s; %s is up to date", localPath, remotePath)
+			return nil
+		}
+		if !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+		log.Debugf(ctx, "Previously uploaded %s no longer exists", remotePath)
+	}
+
+	f, err := os.Open(localPath)
+	if err != nil {
+		return fmt.Errorf("unable to open %s: %w", localPath, errors.Unwrap(err))
+	}
+	defer f.Close()
+
+	cmdio.LogString(ctx, fmt.Sprintf("Uploading %s...", filepath.Base(localPath)))
+	err = client.Write(ctx, name, f, filer.CreateParentDirectories, filer.OverwriteIfExists)
+	if err != nil {
+		return fmt.Errorf("unable to upload %s: %w", localPath, err)
+	}
+
+	cache.Uploads[remotePath] = hash
+	return cache.save()
+}
@@ -0,0 +1,143 @@
+package remote
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/cli/internal/testutil"
+	"github.com/databricks/cli/libs/filer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testBundle(t *testing.T) *bundle.Bundle {
+	dir := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "app"), 0755))
+	return &bundle.Bundle{
+		Config: config.Root{
+			Path: dir,
+			Bundle: config.Bundle{
+				Environment: "default",
+			},
+			Workspace: config.Workspace{
+				ArtifactsPath: "/Users/jane@doe.com/.bundle/artifacts",
+			},
+		},
+	}
+}
+
+func TestRemotePathsChangeWithContents(t *testing.T) {
+	b := testBundle(t)
+	localPath := filepath.Join(b.Config.Path, "app", "app.jar")
+
+	require.NoError(t, os.WriteFile(localPath, []byte("v1"), 0644))
+	v1, err := RemotePaths(b, "app", []string{localPath})
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(localPath, []byte("v2"), 0644))
+	v2, err := RemotePaths(b, "app", []string{localPath})
+	require.NoError(t, err)
+
+	assert.NotEqual(t, v1, v2)
+	assert.Equal(t, "app.jar", filepath.Base(v1[0]))
+	assert.Equal(t, "app.jar", filepath.Base(v2[0]))
+}
+
+func TestRemotePathsWithDuplicateFileNames(t *testing.T) {
+	b := testBundle(t)
+	a := filepath.Join(b.Config.Path, "app.jar")
+	c := filepath.Join(b.Config.Path, "app", "app.jar")
+	require.NoError(t, os.WriteFile(a, nil, 0644))
+	require.NoError(t, os.WriteFile(c, nil, 0644))
+
+	_, err := RemotePaths(b, "app", []string{a, c})
+	assert.ErrorContains(t, err, "have the same file name")
+}
+
+// countingFiler counts the number of files written to the underlying filer.
+type countingFiler struct {
+	filer.Filer
+	writes int
+}
+
+func (f *countingFiler) Write(ctx context.Context, path string, reader io.Reader, mode ...filer.WriteMode) error {
+	f.writes++
+	return f.Filer.Write(ctx, path, reader, mode...)
+}
+
+func setupUpload(t *testing.T) (*bundle.Bundle, *countingFiler, string, string) {
+	b := testBundle(t)
+	localPath := filepath.Join(b.Config.Path, "app", "app.jar")
+	require.NoError(t, os.WriteFile(localPath, []byte("contents"), 0644))
+
+	remotePaths, err := RemotePaths(b, "app", []string{localPath})
+	require.NoError(t, err)
+
+	// Use a local directory in place of the remote directory.
+	remoteDir := t.TempDir()
+	local, err := filer.NewLocalClient(remoteDir)
+	require.NoError(t, err)
+	return b, &countingFiler{Filer: local}, localPath, remotePaths[0]
+}
+
+func TestUploadFileSkipsUploadedFile(t *testing.T) {
+	ctx := testutil.Context()
+	b, f, localPath, remotePath := setupUpload(t)
+
+	require.NoError(t, uploadFile(ctx, b, f, localPath, remotePath))
+	require.NoError(t, uploadFile(ctx, b, f, localPath, remotePath))
+	assert.Equal(t, 1, f.writes)
+}
+
+func TestUploadFileAfterRemoteFileIsDeleted(t *testing.T) {
+	ctx := testutil.Context()
+	b, f, localPath, remotePath := setupUpload(t)
+
+	require.NoError(t, uploadFile(ctx, b, f, localPath, remotePath))
+
+	// Someone else deletes the remote file.
+	require.NoError(t, f.Delete(ctx, "app.jar"))
+
+	require.NoError(t, uploadFile(ctx, b, f, localPath, remotePath))
+	assert.Equal(t, 2, f.writes)
+	_, err := f.Stat(ctx, "app.jar")
+	assert.NoError(t, err)
+}
+
+func TestUploadFileAfterDestroy(t *testing.T) {
+	ctx := testutil.Context()
+	b, f, localPath, remotePath := setupUpload(t)
+
+	// Deploy.
+	require.NoError(t, uploadFile(ctx, b, f, localPath, remotePath))
+
+	// Destroy deletes the remote files and clears the cache.
+	require.NoError(t, f.Delete(ctx, "app.jar"))
+	require.NoError(t, ClearUploadCache(b))
+	cache, err := loadUploadCache(b)
+	require.NoError(t, err)
+	assert.Empty(t, cache.Uploads)
+
+	// Deploy again.
+	require.NoError(t, uploadFile(ctx, b, f, localPath, remotePath))
+	assert.Equal(t, 2, f.writes)
+	_, err = f.Stat(ctx, "app.jar")
+	assert.NoError(t, err)
+}
+
+func TestLoadUploadCacheEmpty(t *testing.T) {
+	b := testBundle(t)
+	cache, err := loadUploadCache(b)
+	require.NoError(t, err)
+	assert.Empty(t, cache.Uploads)
+}
+
+func TestLibraryPath(t *testing.T) {
+	assert.Equal(t, "/Workspace/Users/foo/a.whl", libraryPath("/Users/foo/a.whl"))
+	assert.Equal(t, "/Workspace/Users/foo/a.whl", libraryPath("/Workspace/Users/foo/a.whl"))
+}
@@ -5,7 +5,9 @@ import (
 	"fmt"
 
 	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/artifacts/files"
 	"github.com/databricks/cli/bundle/artifacts/notebook"
+	"github.com/databricks/cli/bundle/artifacts/whl"
 )
 
 func UploadAll() bundle.Mutator {
@@ -37,5 +39,13 @@ func (m *upload) Apply(ctx context.Context, b *bundle.Bundle) error {
 		return bundle.Apply(ctx, b, notebook.Upload(m.name))
 	}
 
+	if artifact.PythonWheel != nil {
+		return bundle.Apply(ctx, b, whl.Upload(m.name))
+	}
+
+	if artifact.Files != nil {
+		return bundle.Apply(ctx, b, files.Upload(m.name))
+	}
+
 	return nil
 }
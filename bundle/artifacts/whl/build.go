@@ -0,0 +1,95 @@
+package whl
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/artifacts/remote"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/exec"
+	"github.com/databricks/cli/python"
+)
+
+type build struct {
+	name string
+}
+
+func Build(name string) bundle.Mutator {
+	return &build{
+		name: name,
+	}
+}
+
+func (m *build) Name() string {
+	return fmt.Sprintf("whl.Build(%s)", m.name)
+}
+
+func (m *build) Apply(ctx context.Context, b *bundle.Bundle) error {
+	a, ok := b.Config.Artifacts[m.name]
+	if !ok {
+		return fmt.Errorf("artifact doesn't exist: %s", m.name)
+	}
+
+	artifact := a.PythonWheel
+
+	dir := filepath.Join(b.Config.Path, artifact.Path)
+	if info, err := os.Stat(dir); err != nil || !info.IsDir() {
+		return fmt.Errorf("python wheel source directory not found: %s", artifact.Path)
+	}
+
+	cmdio.LogString(ctx, fmt.Sprintf("Building %s...", m.name))
+
+	var wheel string
+	var err error
+	if artifact.Build == "" {
+		wheel, err = python.BuildWheel(ctx, dir)
+	} else {
+		wheel, err = buildWithCommand(ctx, dir, artifact.Build)
+	}
+	if err != nil {
+		return fmt.Errorf("unable to build %s: %w", m.name, err)
+	}
+
+	remotePaths, err := remote.RemotePaths(b, m.name, []string{wheel})
+	if err != nil {
+		return err
+	}
+
+	// Store absolute paths.
+	artifact.LocalPath = wheel
+	a.RemotePath = remotePaths[0]
+	return nil
+}
+
+// buildWithCommand runs the specified command and
+// returns the path to the wheel it wrote to the "dist" directory.
+func buildWithCommand(ctx context.Context, dir, command string) (string, error) {
+	dist := filepath.Join(dir, "dist")
+
+	// Remove wheels from previous builds so the built wheel is unambiguous.
+	err := os.RemoveAll(dist)
+	if err != nil {
+		return "", err
+	}
+
+	err = exec.Run(ctx, dir, command)
+	if err != nil {
+		return "", err
+	}
+
+	matches, err := filepath.Glob(filepath.Join(dist, "*.whl"))
+	if err != nil {
+		return "", err
+	}
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("cannot find built wheel in %s", dist)
+	case 1:
+		return matches[0], nil
+	default:
+		return "", fmt.Errorf("found multiple wheels in %s", dist)
+	}
+}
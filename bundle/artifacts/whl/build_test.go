@@ -0,0 +1,93 @@
+package whl
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/cli/bundle/config/interpolation"
+	"github.com/databricks/cli/bundle/config/resources"
+	"github.com/databricks/cli/internal/testutil"
+	"github.com/databricks/databricks-sdk-go/service/compute"
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testBundle(t *testing.T, build string) *bundle.Bundle {
+	dir := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "pkg"), 0755))
+	return &bundle.Bundle{
+		Config: config.Root{
+			Path: dir,
+			Workspace: config.Workspace{
+				ArtifactsPath: "/Users/jane@doe.com/.bundle/artifacts",
+			},
+			Artifacts: map[string]*config.Artifact{
+				"my_wheel": {
+					PythonWheel: &config.PythonWheelArtifact{
+						Path:  "pkg",
+						Build: build,
+					},
+				},
+			},
+			Resources: config.Resources{
+				Jobs: map[string]*resources.Job{
+					"my_job": {
+						JobSettings: &jobs.JobSettings{
+							Tasks: []jobs.Task{
+								{
+									Libraries: []compute.Library{
+										{Whl: "${artifacts.my_wheel.remote_path}"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestBuildWithCommand(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("build command uses a POSIX shell")
+	}
+
+	b := testBundle(t, "mkdir dist && touch dist/my_wheel-0.0.1-py3-none-any.whl")
+	err := bundle.Apply(testutil.Context(), b, Build("my_wheel"))
+	require.NoError(t, err)
+
+	a := b.Config.Artifacts["my_wheel"]
+	assert.Equal(t, filepath.Join(b.Config.Path, "pkg", "dist", "my_wheel-0.0.1-py3-none-any.whl"), a.PythonWheel.LocalPath)
+	assert.Regexp(t, "^/Workspace/Users/jane@doe.com/.bundle/artifacts/my_wheel/[0-9a-f]{64}/my_wheel-0.0.1-py3-none-any.whl$", a.RemotePath)
+
+	// The remote path can be referenced from job task libraries.
+	err = bundle.Apply(testutil.Context(), b, interpolation.Interpolate(interpolation.IncludeLookupsInPath("artifacts")))
+	require.NoError(t, err)
+	assert.Equal(t, a.RemotePath, b.Config.Resources.Jobs["my_job"].Tasks[0].Libraries[0].Whl)
+}
+
+func TestBuildWithCommandWithoutWheel(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("build command uses a POSIX shell")
+	}
+
+	b := testBundle(t, "true")
+	err := bundle.Apply(testutil.Context(), b, Build("my_wheel"))
+	assert.ErrorContains(t, err, "cannot find built wheel")
+}
+
+func TestBuildWithFailingCommand(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("build command uses a POSIX shell")
+	}
+
+	b := testBundle(t, "echo oops && false")
+	err := bundle.Apply(testutil.Context(), b, Build("my_wheel"))
+	assert.ErrorContains(t, err, "oops")
+}
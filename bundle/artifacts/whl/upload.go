@@ -0,0 +1,37 @@
+package whl
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/artifacts/remote"
+)
+
+type upload struct {
+	name string
+}
+
+func Upload(name string) bundle.Mutator {
+	return &upload{
+		name: name,
+	}
+}
+
+func (m *upload) Name() string {
+	return fmt.Sprintf("whl.Upload(%s)", m.name)
+}
+
+func (m *upload) Apply(ctx context.Context, b *bundle.Bundle) error {
+	a, ok := b.Config.Artifacts[m.name]
+	if !ok {
+		return fmt.Errorf("artifact doesn't exist: %s", m.name)
+	}
+
+	artifact := a.PythonWheel
+	if artifact.LocalPath == "" {
+		return fmt.Errorf("artifact %s has not been built", m.name)
+	}
+
+	return remote.UploadFile(ctx, b, artifact.LocalPath, a.RemotePath)
+}
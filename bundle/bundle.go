@@ -41,6 +41,14 @@ type Bundle struct {
 	// if true, we skip approval checks for deploy, destroy resources and delete
 	// files
 	AutoApprove bool
+
+	// Keys of the resources to limit deployment or destruction to.
+	// If empty, all resources in the bundle are deployed or destroyed.
+	TargetResources []string
+
+	// If true, resources that reference the resources in TargetResources
+	// are deployed or destroyed as well.
+	IncludeDependencies bool
 }
 
 func Load(path string) (*Bundle, error) {
@@ -1,11 +1,34 @@
 package config
 
-import "github.com/databricks/databricks-sdk-go/service/workspace"
+import (
+	"path"
+
+	"github.com/databricks/databricks-sdk-go/service/workspace"
+)
 
 // Artifact defines a single local code artifact that can be
 // built/uploaded/referenced in the context of this bundle.
 type Artifact struct {
-	Notebook *NotebookArtifact `json:"notebook,omitempty"`
+	Notebook    *NotebookArtifact    `json:"notebook,omitempty"`
+	PythonWheel *PythonWheelArtifact `json:"python_wheel,omitempty"`
+	Files       *FilesArtifact       `json:"files,omitempty"`
+
+	// RemotePath is the workspace location of the built artifact.
+	// It is synthesized during build step and can be referenced
+	// as ${artifacts.<name>.remote_path}.
+	RemotePath string `json:"remote_path,omitempty" bundle:"readonly"`
+}
+
+// RemoteDir returns the remote directory that holds the uploaded files of a
+// python_wheel or files artifact. It is empty if the artifact has not been built.
+func (a *Artifact) RemoteDir() string {
+	switch {
+	case a.PythonWheel != nil && a.RemotePath != "":
+		return path.Dir(a.RemotePath)
+	case a.Files != nil && len(a.Files.RemotePaths) > 0:
+		return path.Dir(a.Files.RemotePaths[0])
+	}
+	return ""
 }
 
 type NotebookArtifact struct {
@@ -18,3 +41,42 @@ type NotebookArtifact struct {
 	LocalPath  string `json:"local_path,omitempty" bundle:"readonly"`
 	RemotePath string `json:"remote_path,omitempty" bundle:"readonly"`
 }
+
+// PythonWheelArtifact is a Python package that is built into a wheel.
+//
+// The wheel is uploaded to the artifact path of the workspace (see [Workspace.ArtifactsPath])
+// and job tasks refer to it by that path. It is not uploaded to the PEP 503 index on DBFS
+// (see [github.com/databricks/cli/python.UploadWheelToDBFSWithPEP503]). That index is
+// shared by all bundles and users, so deployments of the same package version overwrite
+// each other, and its index pages are not maintained, so pip cannot use it as an index yet.
+// The artifact path is specific to the bundle and environment and is governed by
+// workspace permissions.
+type PythonWheelArtifact struct {
+	// Path to the directory with the Python package, relative to the bundle root.
+	Path string `json:"path"`
+
+	// Build is the command that builds the wheel into the "dist" directory.
+	// It defaults to running "setup.py bdist_wheel" with the detected Python interpreter.
+	Build string `json:"build,omitempty"`
+
+	// Path is synthesized during build step.
+	LocalPath string `json:"local_path,omitempty" bundle:"readonly"`
+}
+
+// FilesArtifact is built by an arbitrary command, for example a JAR built with sbt.
+type FilesArtifact struct {
+	// Path to the directory to run the build command in, relative to the bundle root.
+	Path string `json:"path"`
+
+	// Build is the command that produces the files.
+	Build string `json:"build,omitempty"`
+
+	// Glob pattern that matches the files to upload, relative to Path.
+	Files string `json:"files"`
+
+	// Paths are synthesized during build step.
+	// If a single file matches the pattern, the remote path of the artifact refers to it.
+	// Otherwise, it refers to the directory that holds all files.
+	LocalPaths  []string `json:"local_paths,omitempty" bundle:"readonly"`
+	RemotePaths []string `json:"remote_paths,omitempty" bundle:"readonly"`
+}
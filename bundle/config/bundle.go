@@ -18,6 +18,10 @@ type Bundle struct {
 	// Environment is set by the mutator that selects the environment.
 	Environment string `json:"environment,omitempty" bundle:"readonly"`
 
+	// Mode is set by the mutator that selects the environment.
+	// It is copied from the mode of the selected environment.
+	Mode Mode `json:"mode,omitempty" bundle:"readonly"`
+
 	// Terraform holds configuration related to Terraform.
 	// For example, where to find the binary, which version to use, etc.
 	Terraform *Terraform `json:"terraform,omitempty" bundle:"readonly"`
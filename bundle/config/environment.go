@@ -1,5 +1,19 @@
 package config
 
+import (
+	"github.com/databricks/cli/bundle/config/resources"
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+)
+
+type Mode string
+
+const (
+	// Development mode deploys resources in isolation for the deploying user.
+	// Resource names are prefixed with the name of the user, job schedules and
+	// triggers are paused, and pipelines run in development mode.
+	Development Mode = "development"
+)
+
 // Environment defines overrides for a single environment.
 // This structure is recursively merged into the root configuration.
 type Environment struct {
@@ -7,16 +21,50 @@ type Environment struct {
 	// by the user (through environment variable or command line argument).
 	Default bool `json:"default,omitempty"`
 
+	// Mode specifies how resources in this environment are deployed.
+	// The only supported mode is "development".
+	Mode Mode `json:"mode,omitempty"`
+
+	// Extends specifies the name of another environment that this environment
+	// inherits from. The other environment is merged into the root configuration
+	// before this one, so bundle and workspace fields set in this environment take
+	// precedence. Resources are merged as they are for the root configuration:
+	// fields that are already set are retained.
+	Extends string `json:"extends,omitempty"`
+
 	Bundle *Bundle `json:"bundle,omitempty"`
 
 	Workspace *Workspace `json:"workspace,omitempty"`
 
 	Artifacts map[string]*Artifact `json:"artifacts,omitempty"`
 
+	// Resources are merged into those in the root configuration. Fields that are
+	// set in the root are retained, except for model serving endpoints, where
+	// fields set in the environment take precedence.
 	Resources *Resources `json:"resources,omitempty"`
 
+	// Permissions applied to all resources in addition to those in the root.
+	Permissions []resources.Permission `json:"permissions,omitempty"`
+
+	// Identity that jobs in this environment run as.
+	// Overrides the identity specified in the root.
+	RunAs *jobs.JobRunAs `json:"run_as,omitempty"`
+
 	// Override default values for defined variables
 	// Does not permit defining new variables or redefining existing ones
 	// in the scope of an environment
-	Variables map[string]string `json:"variables,omitempty"`
+	Variables map[string]any `json:"variables,omitempty"`
+
+	// Chain of environments this environment extends, ordered from the
+	// environment that doesn't extend another one to the direct parent.
+	// Also see [Root.ResolveEnvironmentExtends].
+	extends []*Environment
+
+	// References to variables in fields that cannot hold a string.
+	// Paths are relative to the root configuration.
+	variableReferences []variableReference
+
+	// Locations of values in the configuration files.
+	// Paths are relative to the root configuration.
+	locations []valueLocation
 }
@@ -45,17 +45,22 @@ func (s *stringField) dependsOn() []string {
 	return out
 }
 
-func (s *stringField) interpolate(fns []LookupFunction, lookup map[string]string) {
+func (s *stringField) interpolate(fns []LookupFunction, lookup map[string]string) error {
+	var err error
 	out := re.ReplaceAllStringFunc(s.Get(), func(s string) string {
 		// Turn the whole match into the submatch.
 		match := re.FindStringSubmatch(s)
 		for _, fn := range fns {
-			v, err := fn(match[1], lookup)
-			if errors.Is(err, ErrSkipInterpolation) {
+			v, lerr := fn(match[1], lookup)
+			if errors.Is(lerr, ErrSkipInterpolation) {
 				continue
 			}
-			if err != nil {
-				panic(err)
+			if lerr != nil {
+				// Record the first error and leave the reference as is.
+				if err == nil {
+					err = lerr
+				}
+				return s
 			}
 			return v
 		}
@@ -63,14 +68,21 @@ func (s *stringField) interpolate(fns []LookupFunction, lookup map[string]string
 		// No substitution.
 		return s
 	})
+	if err != nil {
+		return err
+	}
 
 	s.Set(out)
+	return nil
 }
 
 type accumulator struct {
 	// all string fields in the bundle config
 	strings map[string]*stringField
 
+	// annotates errors for the field at the specified path (optional)
+	errorAt func(path string, err error) error
+
 	// contains path -> resolved_string mapping for string fields in the config
 	// The resolved strings will NOT contain any variable references that could
 	// have been resolved, however there might still be references that cannot
@@ -127,15 +139,34 @@ func (a *accumulator) walk(scope []string, rv reflect.Value, s setter) {
 
 	switch rv.Type().Kind() {
 	case reflect.String:
-		path := strings.Join(scope, Delimiter)
-		a.strings[path] = newStringField(path, anyGetter{rv}, s)
-
-		// register alias for variable value. `var.foo` would be the alias for
-		// `variables.foo.value`
-		if len(scope) == 3 && scope[0] == "variables" && scope[2] == "value" {
-			aliasPath := strings.Join([]string{variable.VariableReferencePrefix, scope[1]}, Delimiter)
-			a.strings[aliasPath] = a.strings[path]
+		a.register(scope, newStringField(strings.Join(scope, Delimiter), anyGetter{rv}, s))
+	case reflect.Interface:
+		// Skip nil interfaces.
+		if rv.IsNil() {
+			return
 		}
+
+		// Values stored in an interface cannot be set in place.
+		// Assign the interface itself if possible, otherwise use the setter we were given.
+		if rv.CanSet() {
+			s = anySetter{rv}
+		}
+
+		elem := rv.Elem()
+		if elem.Kind() == reflect.String {
+			is := &interfaceString{value: elem.String(), setter: s}
+			a.register(scope, newStringField(strings.Join(scope, Delimiter), is, is))
+			return
+		}
+
+		// Register a string representation of complex variable values so that
+		// they can be referenced from within other strings.
+		if isVariableValue(scope) {
+			cv := &complexValue{value: elem}
+			a.register(scope, newStringField(strings.Join(scope, Delimiter), cv, cv))
+		}
+
+		a.walk(scope, elem, s)
 	case reflect.Struct:
 		a.walkStruct(scope, rv)
 	case reflect.Map:
@@ -157,6 +188,22 @@ func (a *accumulator) walk(scope []string, rv reflect.Value, s setter) {
 	}
 }
 
+// isVariableValue returns true if the scope points to the value of a variable.
+func isVariableValue(scope []string) bool {
+	return len(scope) == 3 && scope[0] == "variables" && scope[2] == "value"
+}
+
+func (a *accumulator) register(scope []string, field *stringField) {
+	a.strings[field.path] = field
+
+	// register alias for variable value. `var.foo` would be the alias for
+	// `variables.foo.value`
+	if isVariableValue(scope) {
+		aliasPath := strings.Join([]string{variable.VariableReferencePrefix, scope[1]}, Delimiter)
+		a.strings[aliasPath] = field
+	}
+}
+
 // walk and gather all string fields in the config
 func (a *accumulator) start(v any) {
 	rv := reflect.ValueOf(v)
@@ -207,7 +254,10 @@ func (a *accumulator) Resolve(path string, seenPaths []string, fns ...LookupFunc
 	}
 
 	// interpolate root string once all variable references in it have been resolved
-	field.interpolate(fns, a.memo)
+	err := field.interpolate(fns, a.memo)
+	if err != nil {
+		return err
+	}
 
 	// record interpolated string in memo
 	a.memo[path] = field.Get()
@@ -224,6 +274,9 @@ func (a *accumulator) expand(fns ...LookupFunction) error {
 	for _, path := range paths {
 		err := a.Resolve(path, []string{path}, fns...)
 		if err != nil {
+			if a.errorAt != nil {
+				err = a.errorAt(path, err)
+			}
 			return err
 		}
 	}
@@ -234,8 +287,8 @@ type interpolate struct {
 	fns []LookupFunction
 }
 
-func (m *interpolate) expand(v any) error {
-	a := accumulator{}
+func (m *interpolate) expand(v any, errorAt func(path string, err error) error) error {
+	a := accumulator{errorAt: errorAt}
 	a.start(v)
 	return a.expand(m.fns...)
 }
@@ -249,5 +302,6 @@ func (m *interpolate) Name() string {
 }
 
 func (m *interpolate) Apply(_ context.Context, b *bundle.Bundle) error {
-	return m.expand(&b.Config)
+	// Errors include the location of the field in the configuration files.
+	return m.expand(&b.Config, b.Config.ErrorAt)
 }
@@ -190,13 +190,13 @@ func TestInterpolationForVariables(t *testing.T) {
 	config := config.Root{
 		Variables: map[string]*variable.Variable{
 			"foo": {
-				Value: &foo,
+				Value: foo,
 			},
 			"bar": {
-				Value: &bar,
+				Value: bar,
 			},
 			"apple": {
-				Value: &apple,
+				Value: apple,
 			},
 		},
 		Bundle: config.Bundle{
@@ -206,9 +206,9 @@ func TestInterpolationForVariables(t *testing.T) {
 
 	err := expand(&config)
 	assert.NoError(t, err)
-	assert.Equal(t, "abc", *(config.Variables["foo"].Value))
-	assert.Equal(t, "abc def", *(config.Variables["bar"].Value))
-	assert.Equal(t, "abc abc def", *(config.Variables["apple"].Value))
+	assert.Equal(t, "abc", config.Variables["foo"].Value)
+	assert.Equal(t, "abc def", config.Variables["bar"].Value)
+	assert.Equal(t, "abc abc def", config.Variables["apple"].Value)
 	assert.Equal(t, "abc abc def abc", config.Bundle.Name)
 }
 
@@ -218,10 +218,10 @@ func TestInterpolationLoopForVariables(t *testing.T) {
 	config := config.Root{
 		Variables: map[string]*variable.Variable{
 			"foo": {
-				Value: &foo,
+				Value: foo,
 			},
 			"bar": {
-				Value: &bar,
+				Value: bar,
 			},
 		},
 		Bundle: config.Bundle{
@@ -238,7 +238,7 @@ func TestInterpolationInvalidVariableReference(t *testing.T) {
 	config := config.Root{
 		Variables: map[string]*variable.Variable{
 			"foo": {
-				Value: &foo,
+				Value: foo,
 			},
 		},
 		Bundle: config.Bundle{
@@ -1,6 +1,10 @@
 package interpolation
 
-import "reflect"
+import (
+	"reflect"
+
+	"github.com/databricks/cli/bundle/config/variable"
+)
 
 // String values in maps are not addressable and therefore not settable
 // through Go's reflection mechanism. This interface solves this limitation
@@ -20,6 +24,10 @@ type anySetter struct {
 }
 
 func (s anySetter) Set(str string) {
+	if s.rv.Kind() == reflect.Interface {
+		s.rv.Set(reflect.ValueOf(str))
+		return
+	}
 	s.rv.SetString(str)
 }
 
@@ -46,3 +54,41 @@ type anyGetter struct {
 func (g anyGetter) Get() string {
 	return g.rv.String()
 }
+
+// interfaceString is the getter and setter for a string stored in an interface.
+// The string is copied out of the interface, so it keeps track of the last value set.
+type interfaceString struct {
+	value  string
+	setter setter
+}
+
+func (s *interfaceString) Get() string {
+	return s.value
+}
+
+func (s *interfaceString) Set(str string) {
+	s.setter.Set(str)
+	s.value = str
+}
+
+// complexValue is the getter and setter for the string representation of
+// a variable value that is not a string (e.g. a list or a map).
+//
+// Setting it only updates the string representation. The underlying value
+// is interpolated through its own string fields.
+type complexValue struct {
+	value reflect.Value
+
+	interpolated *string
+}
+
+func (c *complexValue) Get() string {
+	if c.interpolated != nil {
+		return *c.interpolated
+	}
+	return variable.ToString(c.value.Interface())
+}
+
+func (c *complexValue) Set(str string) {
+	c.interpolated = &str
+}
@@ -0,0 +1,142 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Location is the position of a value in a configuration file.
+type Location struct {
+	File   string
+	Line   int
+	Column int
+}
+
+func (l Location) String() string {
+	return fmt.Sprintf("%s:%d:%d", l.File, l.Line, l.Column)
+}
+
+// valueLocation associates a path in the configuration tree with a location.
+// Paths use the same representation as variable references.
+type valueLocation struct {
+	path     []any
+	location Location
+}
+
+func (l valueLocation) String() string {
+	return variableReference{path: l.path}.String()
+}
+
+// extractLocations returns the location of every value in the specified YAML document.
+// Values in mappings are located by their key, such that the location of a struct or
+// map points at its name.
+func extractLocations(file string, node *yaml.Node) []valueLocation {
+	var out []valueLocation
+	var walk func(node *yaml.Node, path []any)
+	walk = func(node *yaml.Node, path []any) {
+		switch node.Kind {
+		case yaml.DocumentNode:
+			for _, n := range node.Content {
+				walk(n, path)
+			}
+		case yaml.AliasNode:
+			walk(node.Alias, path)
+		case yaml.MappingNode:
+			for i := 0; i+1 < len(node.Content); i += 2 {
+				key, value := node.Content[i], node.Content[i+1]
+
+				// Keys merged from an anchor are located where the anchor is defined.
+				if key.Value == "<<" && key.Tag == "!!merge" {
+					walk(value, path)
+					continue
+				}
+
+				p := append(append([]any{}, path...), key.Value)
+				out = append(out, valueLocation{
+					path:     p,
+					location: Location{File: file, Line: key.Line, Column: key.Column},
+				})
+				walk(value, p)
+			}
+		case yaml.SequenceNode:
+			for i, value := range node.Content {
+				p := append(append([]any{}, path...), i)
+				out = append(out, valueLocation{
+					path:     p,
+					location: Location{File: file, Line: value.Line, Column: value.Column},
+				})
+				walk(value, p)
+			}
+		}
+	}
+
+	walk(node, nil)
+	return out
+}
+
+// findLocation returns the location of the value at the specified path.
+// If the location of the value is not known, the location of the closest
+// enclosing value is returned instead.
+func findLocation(locs []valueLocation, path string) (Location, bool) {
+	for {
+		// Later locations take precedence; they originate from later merges.
+		for i := len(locs) - 1; i >= 0; i-- {
+			if locs[i].String() == path {
+				return locs[i].location, true
+			}
+		}
+		if path == "" {
+			return Location{}, false
+		}
+
+		// Strip the last path component.
+		i := strings.LastIndexAny(path, ".[")
+		if i < 0 {
+			path = ""
+		} else {
+			path = path[:i]
+		}
+	}
+}
+
+// errorAt prefixes the specified error with the location of the value at the specified path.
+func errorAt(locs []valueLocation, path string, err error) error {
+	if err == nil {
+		return nil
+	}
+	loc, ok := findLocation(locs, path)
+	if !ok {
+		return err
+	}
+	return fmt.Errorf("%s: %w", loc, err)
+}
+
+// Location returns the location of the value at the specified path in the
+// configuration files, for example "resources.jobs.foo.tasks[0].task_key".
+// If the location of the value is not known, the location of the closest
+// enclosing value is returned instead.
+func (r *Root) Location(path string) (Location, bool) {
+	return findLocation(r.locations, path)
+}
+
+// ErrorAt prefixes the specified error with the location of the value at the
+// specified path in the configuration files, if it is known.
+func (r *Root) ErrorAt(path string, err error) error {
+	return errorAt(r.locations, path, err)
+}
+
+// resourcePosition returns a function that returns the position of the
+// definition of a resource according to the specified locations.
+func resourcePosition(locs []valueLocation) func(kind, key string) (int, int) {
+	return func(kind, key string) (int, int) {
+		path := fmt.Sprintf("resources.%s.%s", kind, key)
+		for _, loc := range locs {
+			if loc.String() == path {
+				return loc.location.Line, loc.location.Column
+			}
+		}
+		return 0, 0
+	}
+}
@@ -0,0 +1,60 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestExtractLocations(t *testing.T) {
+	raw := `
+defaults: &defaults
+  spark_version: 13.1.x
+foo:
+  <<: *defaults
+  list:
+    - a
+    - b
+`
+	var node yaml.Node
+	err := yaml.Unmarshal([]byte(raw), &node)
+	require.NoError(t, err)
+
+	locs := extractLocations("file.yml", &node)
+	actual := make(map[string]string)
+	for _, loc := range locs {
+		actual[loc.String()] = loc.location.String()
+	}
+
+	assert.Equal(t, map[string]string{
+		"defaults":               "file.yml:2:1",
+		"defaults.spark_version": "file.yml:3:3",
+		"foo":                    "file.yml:4:1",
+		"foo.spark_version":      "file.yml:3:3",
+		"foo.list":               "file.yml:6:3",
+		"foo.list[0]":            "file.yml:7:7",
+		"foo.list[1]":            "file.yml:8:7",
+	}, actual)
+}
+
+func TestRootErrorAt(t *testing.T) {
+	r := &Root{
+		locations: []valueLocation{
+			{path: []any{"resources"}, location: Location{File: "a.yml", Line: 1, Column: 1}},
+			{path: []any{"resources", "jobs", "foo"}, location: Location{File: "a.yml", Line: 3, Column: 5}},
+			{path: []any{"resources", "jobs", "foo"}, location: Location{File: "b.yml", Line: 7, Column: 5}},
+		},
+	}
+
+	err := r.ErrorAt("resources.jobs.foo.name", assert.AnError)
+	assert.ErrorIs(t, err, assert.AnError)
+	assert.ErrorContains(t, err, "b.yml:7:5: ")
+
+	err = r.ErrorAt("resources.pipelines.bar", assert.AnError)
+	assert.ErrorContains(t, err, "a.yml:1:1: ")
+
+	err = r.ErrorAt("workspace.host", assert.AnError)
+	assert.Equal(t, assert.AnError, err)
+}
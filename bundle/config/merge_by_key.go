@@ -0,0 +1,236 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+	"github.com/databricks/databricks-sdk-go/service/pipelines"
+	"github.com/imdario/mergo"
+)
+
+// mergeByKey merges elements of a slice that have the same key. Elements are
+// merged into the first element with that key, in order, such that fields set
+// in later elements take precedence. Elements without a key are left as is.
+// Elements with a key in `remove` are dropped.
+//
+// Fields are merged with [mergo.WithOverride], which only considers fields that
+// are set to a non-zero value. This means a later element cannot reset a field
+// to its zero value, for example `num_workers: 0` or `false` in an environment
+// does not override a non-zero value in the root configuration.
+//
+// It returns the resulting slice and, for every element of the input slice,
+// its index in the resulting slice (or -1 if it was dropped).
+func mergeByKey[T any](in []T, key func(*T) string, remove []string) ([]T, []int, error) {
+	removed := make(map[string]bool)
+	for _, k := range remove {
+		removed[k] = true
+	}
+
+	var out []T
+	index := make([]int, len(in))
+	seen := make(map[string]int)
+	for i := range in {
+		k := key(&in[i])
+		if removed[k] {
+			index[i] = -1
+			continue
+		}
+		if j, ok := seen[k]; ok && k != "" {
+			err := mergo.Merge(&out[j], in[i], mergo.WithOverride)
+			if err != nil {
+				return nil, nil, err
+			}
+			index[i] = j
+			continue
+		}
+		seen[k] = len(out)
+		index[i] = len(out)
+		out = append(out, in[i])
+	}
+
+	return out, index, nil
+}
+
+// remapPath updates the slice index that follows the specified prefix in a path,
+// according to `index` (see [mergeByKey]). It returns false if the path refers
+// to an element that was dropped.
+func remapPath(path []any, prefix []any, index []int) ([]any, bool) {
+	if len(path) <= len(prefix) || !hasPathPrefix(path, prefix) {
+		return path, true
+	}
+	i, ok := path[len(prefix)].(int)
+	if !ok || i >= len(index) {
+		return path, true
+	}
+	if index[i] < 0 {
+		return nil, false
+	}
+	path = append([]any{}, path...)
+	path[len(prefix)] = index[i]
+	return path, true
+}
+
+// remapVariableReferences applies [remapPath] to the paths of variable references.
+// References to elements that were dropped are removed.
+func remapVariableReferences(refs []variableReference, prefix []any, index []int) []variableReference {
+	var out []variableReference
+	for _, ref := range refs {
+		path, ok := remapPath(ref.path, prefix, index)
+		if !ok {
+			continue
+		}
+		out = append(out, variableReference{path: path, name: ref.name})
+	}
+	return out
+}
+
+// remapLocations applies [remapPath] to the paths of value locations.
+// Locations of elements that were dropped are removed.
+func remapLocations(locs []valueLocation, prefix []any, index []int) []valueLocation {
+	var out []valueLocation
+	for _, loc := range locs {
+		path, ok := remapPath(loc.path, prefix, index)
+		if !ok {
+			continue
+		}
+		out = append(out, valueLocation{path: path, location: loc.location})
+	}
+	return out
+}
+
+func hasPathPrefix(path []any, prefix []any) bool {
+	for i := range prefix {
+		if path[i] != prefix[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// mergeResourcesByKey merges job tasks by their task key, job clusters by their
+// job cluster key, and pipeline clusters by their label. This is needed because
+// merging an environment appends its slices to the slices in the root configuration.
+//
+// The variable references and value locations of the environment that is being
+// merged are updated to account for the merge, as are those of the root.
+func (r *Root) mergeResourcesByKey(refs []variableReference, locs []valueLocation) ([]variableReference, []valueLocation, error) {
+	var err error
+	var index []int
+
+	remap := func(prefix ...any) {
+		r.variableReferences = remapVariableReferences(r.variableReferences, prefix, index)
+		r.locations = remapLocations(r.locations, prefix, index)
+		refs = remapVariableReferences(refs, prefix, index)
+		locs = remapLocations(locs, prefix, index)
+	}
+
+	for name, job := range r.Resources.Jobs {
+		if job.JobSettings == nil {
+			continue
+		}
+
+		job.Tasks, index, err = mergeByKey(job.Tasks, func(t *jobs.Task) string {
+			return t.TaskKey
+		}, job.RemoveTasks)
+		if err != nil {
+			return nil, nil, err
+		}
+		remap("resources", "jobs", name, "tasks")
+
+		job.JobClusters, index, err = mergeByKey(job.JobClusters, func(c *jobs.JobCluster) string {
+			return c.JobClusterKey
+		}, job.RemoveJobClusters)
+		if err != nil {
+			return nil, nil, err
+		}
+		remap("resources", "jobs", name, "job_clusters")
+
+		job.RemoveTasks = nil
+		job.RemoveJobClusters = nil
+	}
+
+	for name, pipeline := range r.Resources.Pipelines {
+		if pipeline.PipelineSpec == nil {
+			continue
+		}
+
+		pipeline.Clusters, index, err = mergeByKey(pipeline.Clusters, func(c *pipelines.PipelineCluster) string {
+			return c.Label
+		}, pipeline.RemoveClusters)
+		if err != nil {
+			return nil, nil, err
+		}
+		remap("resources", "pipelines", name, "clusters")
+
+		pipeline.RemoveClusters = nil
+	}
+
+	return refs, locs, nil
+}
+
+// duplicateKey returns the index of the first element of a slice that has the
+// same key as an element before it. Elements without a key are ignored.
+func duplicateKey[T any](in []T, key func(*T) string) (int, bool) {
+	seen := make(map[string]bool)
+	for i := range in {
+		k := key(&in[i])
+		if k == "" {
+			continue
+		}
+		if seen[k] {
+			return i, true
+		}
+		seen[k] = true
+	}
+	return -1, false
+}
+
+// verifyUniqueKeys returns an error if a job defines multiple tasks with the same
+// task key or multiple job clusters with the same job cluster key, or if a pipeline
+// defines multiple clusters with the same label. These would otherwise be merged
+// by [mergeResourcesByKey] without warning.
+func verifyUniqueKeys(res *Resources, locs []valueLocation) error {
+	jobNames := make([]string, 0, len(res.Jobs))
+	for name := range res.Jobs {
+		jobNames = append(jobNames, name)
+	}
+	sort.Strings(jobNames)
+
+	for _, name := range jobNames {
+		job := res.Jobs[name]
+		if job == nil || job.JobSettings == nil {
+			continue
+		}
+
+		if i, ok := duplicateKey(job.Tasks, func(t *jobs.Task) string { return t.TaskKey }); ok {
+			path := fmt.Sprintf("resources.jobs.%s.tasks[%d].task_key", name, i)
+			return errorAt(locs, path, fmt.Errorf("job %s has multiple tasks with task_key %q", name, job.Tasks[i].TaskKey))
+		}
+
+		if i, ok := duplicateKey(job.JobClusters, func(c *jobs.JobCluster) string { return c.JobClusterKey }); ok {
+			path := fmt.Sprintf("resources.jobs.%s.job_clusters[%d].job_cluster_key", name, i)
+			return errorAt(locs, path, fmt.Errorf("job %s has multiple job clusters with job_cluster_key %q", name, job.JobClusters[i].JobClusterKey))
+		}
+	}
+
+	pipelineNames := make([]string, 0, len(res.Pipelines))
+	for name := range res.Pipelines {
+		pipelineNames = append(pipelineNames, name)
+	}
+	sort.Strings(pipelineNames)
+
+	for _, name := range pipelineNames {
+		pipeline := res.Pipelines[name]
+		if pipeline == nil || pipeline.PipelineSpec == nil {
+			continue
+		}
+
+		if i, ok := duplicateKey(pipeline.Clusters, func(c *pipelines.PipelineCluster) string { return c.Label }); ok {
+			path := fmt.Sprintf("resources.pipelines.%s.clusters[%d].label", name, i)
+			return errorAt(locs, path, fmt.Errorf("pipeline %s has multiple clusters with label %q", name, pipeline.Clusters[i].Label))
+		}
+	}
+
+	return nil
+}
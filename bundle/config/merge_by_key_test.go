@@ -0,0 +1,51 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type keyedElement struct {
+	Key   string
+	Value string
+	Other string
+}
+
+func TestMergeByKey(t *testing.T) {
+	in := []keyedElement{
+		{Key: "a", Value: "1", Other: "x"},
+		{Key: "b", Value: "2"},
+		{Key: "", Value: "3"},
+		{Key: "a", Value: "4"},
+		{Key: "c", Value: "5"},
+		{Key: "", Value: "6"},
+	}
+
+	out, index, err := mergeByKey(in, func(e *keyedElement) string { return e.Key }, []string{"b"})
+	require.NoError(t, err)
+	assert.Equal(t, []keyedElement{
+		{Key: "a", Value: "4", Other: "x"},
+		{Key: "", Value: "3"},
+		{Key: "c", Value: "5"},
+		{Key: "", Value: "6"},
+	}, out)
+	assert.Equal(t, []int{0, -1, 1, 0, 2, 3}, index)
+}
+
+func TestRemapVariableReferences(t *testing.T) {
+	refs := []variableReference{
+		{path: []any{"resources", "jobs", "foo", "tasks", 0, "max_retries"}, name: "a"},
+		{path: []any{"resources", "jobs", "foo", "tasks", 1, "max_retries"}, name: "b"},
+		{path: []any{"resources", "jobs", "foo", "tasks", 2, "max_retries"}, name: "c"},
+		{path: []any{"resources", "jobs", "bar", "tasks", 2, "max_retries"}, name: "d"},
+	}
+
+	out := remapVariableReferences(refs, []any{"resources", "jobs", "foo", "tasks"}, []int{0, -1, 0})
+	assert.Equal(t, []variableReference{
+		{path: []any{"resources", "jobs", "foo", "tasks", 0, "max_retries"}, name: "a"},
+		{path: []any{"resources", "jobs", "foo", "tasks", 0, "max_retries"}, name: "c"},
+		{path: []any{"resources", "jobs", "bar", "tasks", 2, "max_retries"}, name: "d"},
+	}, out)
+}
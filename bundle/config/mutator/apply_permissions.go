@@ -0,0 +1,179 @@
+package mutator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config/resources"
+	"github.com/databricks/cli/libs/log"
+)
+
+type applyPermissions struct{}
+
+// ApplyPermissions merges the top-level permissions of the bundle into the
+// permissions of every resource that supports access control lists.
+// Top-level permission levels are mapped to the equivalent level of each resource type.
+// Every principal ends up with a single permission per resource: permissions defined on
+// the resource take precedence over top-level permissions.
+func ApplyPermissions() bundle.Mutator {
+	return &applyPermissions{}
+}
+
+func (m *applyPermissions) Name() string {
+	return "ApplyPermissions"
+}
+
+// levelsByKind maps the supported levels of top-level permissions to the equivalent
+// level for each resource type. Resource types without an equivalent level are absent,
+// for example clusters don't have a permission level that only allows viewing.
+var levelsByKind = map[string]map[string]string{
+	"CAN_VIEW": {
+		"jobs":                    "CAN_VIEW",
+		"pipelines":               "CAN_VIEW",
+		"models":                  "CAN_READ",
+		"experiments":             "CAN_READ",
+		"model_serving_endpoints": "CAN_VIEW",
+	},
+	"CAN_RUN": {
+		"jobs":                    "CAN_MANAGE_RUN",
+		"pipelines":               "CAN_RUN",
+		"model_serving_endpoints": "CAN_QUERY",
+		"clusters":                "CAN_ATTACH_TO",
+	},
+	"CAN_MANAGE": {
+		"jobs":                    "CAN_MANAGE",
+		"pipelines":               "CAN_MANAGE",
+		"models":                  "CAN_MANAGE",
+		"experiments":             "CAN_MANAGE",
+		"model_serving_endpoints": "CAN_MANAGE",
+		"clusters":                "CAN_MANAGE",
+	},
+}
+
+// permissionsForKind returns the top-level permissions with their levels mapped to the
+// levels of the specified resource type. Permissions that don't apply to the type are skipped.
+func permissionsForKind(ctx context.Context, kind string, top []resources.Permission) []resources.Permission {
+	var out []resources.Permission
+	for _, p := range top {
+		level, ok := levelsByKind[p.Level][kind]
+		if !ok {
+			log.Warnf(ctx, "Skipping top-level permission %s for %s; it doesn't apply to this resource type", p.Level, kind)
+			continue
+		}
+		p.Level = level
+		out = append(out, p)
+	}
+	return out
+}
+
+// levelRank orders the supported levels of top-level permissions.
+var levelRank = map[string]int{
+	"CAN_VIEW":   0,
+	"CAN_RUN":    1,
+	"CAN_MANAGE": 2,
+}
+
+// principal returns the user, service principal, or group that a permission applies to.
+func principal(p resources.Permission) string {
+	switch {
+	case p.UserName != "":
+		return "user:" + p.UserName
+	case p.ServicePrincipalName != "":
+		return "service_principal:" + p.ServicePrincipalName
+	default:
+		return "group:" + p.GroupName
+	}
+}
+
+// highestPermissions returns the top-level permissions with a single entry per principal.
+// If a principal is listed multiple times, the entry with the highest level is retained.
+func highestPermissions(top []resources.Permission) []resources.Permission {
+	var out []resources.Permission
+	index := make(map[string]int)
+	for _, p := range top {
+		i, ok := index[principal(p)]
+		if !ok {
+			index[principal(p)] = len(out)
+			out = append(out, p)
+			continue
+		}
+		if levelRank[p.Level] > levelRank[out[i].Level] {
+			out[i] = p
+		}
+	}
+	return out
+}
+
+// mergePermissions returns the permissions of a resource followed by the
+// top-level permissions, with a single entry per principal. The first entry
+// for a principal is retained, such that permissions of the resource take precedence.
+// Terraform rejects permissions with multiple entries for the same principal.
+func mergePermissions(resource []resources.Permission, top []resources.Permission) []resources.Permission {
+	if len(resource) == 0 && len(top) == 0 {
+		return resource
+	}
+
+	var out []resources.Permission
+	seen := make(map[string]bool)
+	for _, list := range [][]resources.Permission{resource, top} {
+		for _, p := range list {
+			if seen[principal(p)] {
+				continue
+			}
+			seen[principal(p)] = true
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+func (m *applyPermissions) Apply(ctx context.Context, b *bundle.Bundle) error {
+	top := b.Config.Permissions
+	for _, p := range top {
+		if _, ok := levelsByKind[p.Level]; !ok {
+			return fmt.Errorf("unsupported level %s for top-level permissions; supported levels are CAN_VIEW, CAN_RUN and CAN_MANAGE", p.Level)
+		}
+	}
+	top = highestPermissions(top)
+
+	r := b.Config.Resources
+	if len(r.Jobs) > 0 {
+		perms := permissionsForKind(ctx, "jobs", top)
+		for _, job := range r.Jobs {
+			job.Permissions = mergePermissions(job.Permissions, perms)
+		}
+	}
+	if len(r.Pipelines) > 0 {
+		perms := permissionsForKind(ctx, "pipelines", top)
+		for _, pipeline := range r.Pipelines {
+			pipeline.Permissions = mergePermissions(pipeline.Permissions, perms)
+		}
+	}
+	if len(r.Models) > 0 {
+		perms := permissionsForKind(ctx, "models", top)
+		for _, model := range r.Models {
+			model.Permissions = mergePermissions(model.Permissions, perms)
+		}
+	}
+	if len(r.Experiments) > 0 {
+		perms := permissionsForKind(ctx, "experiments", top)
+		for _, experiment := range r.Experiments {
+			experiment.Permissions = mergePermissions(experiment.Permissions, perms)
+		}
+	}
+	if len(r.ModelServingEndpoints) > 0 {
+		perms := permissionsForKind(ctx, "model_serving_endpoints", top)
+		for _, endpoint := range r.ModelServingEndpoints {
+			endpoint.Permissions = mergePermissions(endpoint.Permissions, perms)
+		}
+	}
+	if len(r.Clusters) > 0 {
+		perms := permissionsForKind(ctx, "clusters", top)
+		for _, cluster := range r.Clusters {
+			cluster.Permissions = mergePermissions(cluster.Permissions, perms)
+		}
+	}
+
+	return nil
+}
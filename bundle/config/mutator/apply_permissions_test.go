@@ -0,0 +1,151 @@
+package mutator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/cli/bundle/config/mutator"
+	"github.com/databricks/cli/bundle/config/resources"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyPermissions(t *testing.T) {
+	oncall := resources.Permission{Level: "CAN_MANAGE", GroupName: "oncall"}
+	viewer := resources.Permission{Level: "CAN_VIEW", UserName: "jane@example.com"}
+
+	bundle := &bundle.Bundle{
+		Config: config.Root{
+			Permissions: []resources.Permission{oncall},
+			Resources: config.Resources{
+				Jobs: map[string]*resources.Job{
+					"job1": {Permissions: []resources.Permission{viewer}},
+					"job2": {Permissions: []resources.Permission{oncall, viewer}},
+				},
+				Pipelines: map[string]*resources.Pipeline{
+					"pipeline1": {},
+				},
+				Clusters: map[string]*resources.Cluster{
+					"cluster1": {},
+				},
+			},
+		},
+	}
+
+	err := mutator.ApplyPermissions().Apply(context.Background(), bundle)
+	require.NoError(t, err)
+
+	r := bundle.Config.Resources
+	assert.Equal(t, []resources.Permission{viewer, oncall}, r.Jobs["job1"].Permissions)
+	assert.Equal(t, []resources.Permission{oncall, viewer}, r.Jobs["job2"].Permissions)
+	assert.Equal(t, []resources.Permission{oncall}, r.Pipelines["pipeline1"].Permissions)
+	assert.Equal(t, []resources.Permission{oncall}, r.Clusters["cluster1"].Permissions)
+}
+
+func TestApplyPermissionsDeduplicatesResourcePermissions(t *testing.T) {
+	viewer := resources.Permission{Level: "CAN_VIEW", UserName: "jane@example.com"}
+
+	bundle := &bundle.Bundle{
+		Config: config.Root{
+			Resources: config.Resources{
+				Jobs: map[string]*resources.Job{
+					"job1": {Permissions: []resources.Permission{viewer, viewer}},
+					"job2": {},
+				},
+			},
+		},
+	}
+
+	err := mutator.ApplyPermissions().Apply(context.Background(), bundle)
+	require.NoError(t, err)
+	assert.Equal(t, []resources.Permission{viewer}, bundle.Config.Resources.Jobs["job1"].Permissions)
+	assert.Nil(t, bundle.Config.Resources.Jobs["job2"].Permissions)
+}
+
+func TestApplyPermissionsWithConflictingLevels(t *testing.T) {
+	bundle := &bundle.Bundle{
+		Config: config.Root{
+			Permissions: []resources.Permission{
+				{Level: "CAN_VIEW", UserName: "jane@example.com"},
+				{Level: "CAN_VIEW", GroupName: "data"},
+				{Level: "CAN_MANAGE", GroupName: "data"},
+				{Level: "CAN_RUN", ServicePrincipalName: "deployer"},
+			},
+			Resources: config.Resources{
+				Jobs: map[string]*resources.Job{
+					"job": {
+						Permissions: []resources.Permission{
+							{Level: "CAN_MANAGE", UserName: "jane@example.com"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	err := mutator.ApplyPermissions().Apply(context.Background(), bundle)
+	require.NoError(t, err)
+
+	// The resource-level permission takes precedence over the top-level permission
+	// for the same principal, and of multiple top-level permissions for the same
+	// principal the one with the highest level is retained.
+	assert.Equal(t, []resources.Permission{
+		{Level: "CAN_MANAGE", UserName: "jane@example.com"},
+		{Level: "CAN_MANAGE", GroupName: "data"},
+		{Level: "CAN_MANAGE_RUN", ServicePrincipalName: "deployer"},
+	}, bundle.Config.Resources.Jobs["job"].Permissions)
+}
+
+func TestApplyPermissionsMapsLevelsToResourceTypes(t *testing.T) {
+	viewers := resources.Permission{Level: "CAN_VIEW", GroupName: "viewers"}
+	runners := resources.Permission{Level: "CAN_RUN", GroupName: "runners"}
+	managers := resources.Permission{Level: "CAN_MANAGE", GroupName: "managers"}
+
+	bundle := &bundle.Bundle{
+		Config: config.Root{
+			Permissions: []resources.Permission{viewers, runners, managers},
+			Resources: config.Resources{
+				Jobs:                  map[string]*resources.Job{"job": {}},
+				Pipelines:             map[string]*resources.Pipeline{"pipeline": {}},
+				Models:                map[string]*resources.MlflowModel{"model": {}},
+				Experiments:           map[string]*resources.MlflowExperiment{"experiment": {}},
+				ModelServingEndpoints: map[string]*resources.ModelServingEndpoint{"endpoint": {}},
+				Clusters:              map[string]*resources.Cluster{"cluster": {}},
+			},
+		},
+	}
+
+	err := mutator.ApplyPermissions().Apply(context.Background(), bundle)
+	require.NoError(t, err)
+
+	levels := func(permissions []resources.Permission) []string {
+		var out []string
+		for _, p := range permissions {
+			out = append(out, p.GroupName+":"+p.Level)
+		}
+		return out
+	}
+
+	r := bundle.Config.Resources
+	assert.Equal(t, []string{"viewers:CAN_VIEW", "runners:CAN_MANAGE_RUN", "managers:CAN_MANAGE"}, levels(r.Jobs["job"].Permissions))
+	assert.Equal(t, []string{"viewers:CAN_VIEW", "runners:CAN_RUN", "managers:CAN_MANAGE"}, levels(r.Pipelines["pipeline"].Permissions))
+	assert.Equal(t, []string{"viewers:CAN_READ", "managers:CAN_MANAGE"}, levels(r.Models["model"].Permissions))
+	assert.Equal(t, []string{"viewers:CAN_READ", "managers:CAN_MANAGE"}, levels(r.Experiments["experiment"].Permissions))
+	assert.Equal(t, []string{"viewers:CAN_VIEW", "runners:CAN_QUERY", "managers:CAN_MANAGE"}, levels(r.ModelServingEndpoints["endpoint"].Permissions))
+	assert.Equal(t, []string{"runners:CAN_ATTACH_TO", "managers:CAN_MANAGE"}, levels(r.Clusters["cluster"].Permissions))
+}
+
+func TestApplyPermissionsUnsupportedLevel(t *testing.T) {
+	bundle := &bundle.Bundle{
+		Config: config.Root{
+			Permissions: []resources.Permission{
+				{Level: "CAN_MANAGE_RUN", GroupName: "runners"},
+			},
+		},
+	}
+
+	err := mutator.ApplyPermissions().Apply(context.Background(), bundle)
+	assert.ErrorContains(t, err, "unsupported level CAN_MANAGE_RUN for top-level permissions")
+}
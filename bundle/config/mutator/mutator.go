@@ -9,6 +9,7 @@ func DefaultMutators() []bundle.Mutator {
 		DefineDefaultInclude(),
 		ProcessRootIncludes(),
 		DefineDefaultEnvironment(),
+		ResolveEnvironmentExtends(),
 		LoadGitDetails(),
 	}
 }
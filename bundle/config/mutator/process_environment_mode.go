@@ -0,0 +1,148 @@
+package mutator
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+	"github.com/databricks/databricks-sdk-go/service/ml"
+	"github.com/databricks/databricks-sdk-go/service/pipelines"
+)
+
+type processEnvironmentMode struct{}
+
+// ProcessEnvironmentMode applies the mode of the selected environment to the resources in the bundle.
+func ProcessEnvironmentMode() bundle.Mutator {
+	return &processEnvironmentMode{}
+}
+
+func (m *processEnvironmentMode) Name() string {
+	return "ProcessEnvironmentMode"
+}
+
+// Matches characters that are not permitted in names that must be identifiers,
+// such as the names of Unity Catalog schemas and model serving endpoints.
+var nonIdentifierRegex = regexp.MustCompile(`[^a-zA-Z0-9_]`)
+
+// processDevelopmentMode isolates the resources deployed by the current user
+// from the resources deployed by other users of the same bundle.
+func processDevelopmentMode(b *bundle.Bundle) error {
+	currentUser := b.Config.Workspace.CurrentUser
+	if currentUser == nil || currentUser.UserName == "" {
+		return fmt.Errorf("unable to apply development mode: current user not set")
+	}
+
+	// The short name of a user is the part of their user name before the "@".
+	shortName := strings.Split(currentUser.UserName, "@")[0]
+	prefix := fmt.Sprintf("[dev %s] ", shortName)
+
+	// The names of Unity Catalog schemas and model serving endpoints may only contain
+	// letters, digits, and underscores (and dashes for endpoints), so the API rejects the
+	// brackets and spaces of the regular prefix. These names use a prefix with the same
+	// information that is a valid identifier, for example `dev_jane_doe_`.
+	identifierPrefix := fmt.Sprintf("dev_%s_", nonIdentifierRegex.ReplaceAllString(shortName, "_"))
+
+	r := b.Config.Resources
+	for _, job := range r.Jobs {
+		if job.JobSettings == nil {
+			continue
+		}
+		job.Name = prefix + job.Name
+		if job.Tags == nil {
+			job.Tags = make(map[string]string)
+		}
+		job.Tags["dev"] = shortName
+		if job.Schedule != nil {
+			job.Schedule.PauseStatus = jobs.PauseStatusPaused
+		}
+		if job.Trigger != nil {
+			job.Trigger.PauseStatus = jobs.PauseStatusPaused
+		}
+		if job.Continuous != nil {
+			job.Continuous.PauseStatus = jobs.PauseStatusPaused
+		}
+	}
+
+	for _, pipeline := range r.Pipelines {
+		if pipeline.PipelineSpec == nil {
+			continue
+		}
+		pipeline.Name = prefix + pipeline.Name
+		pipeline.Development = true
+
+		// Pipelines don't have tags of their own; their clusters are tagged instead.
+		// A pipeline without cluster settings runs on a cluster with the "default" label.
+		if len(pipeline.Clusters) == 0 {
+			pipeline.Clusters = []pipelines.PipelineCluster{{Label: "default"}}
+		}
+		for i := range pipeline.Clusters {
+			if pipeline.Clusters[i].CustomTags == nil {
+				pipeline.Clusters[i].CustomTags = make(map[string]string)
+			}
+			pipeline.Clusters[i].CustomTags["dev"] = shortName
+		}
+	}
+
+	for _, model := range r.Models {
+		if model.Model == nil {
+			continue
+		}
+		model.Name = prefix + model.Name
+		model.Tags = append(model.Tags, ml.ModelTag{Key: "dev", Value: shortName})
+	}
+
+	for _, experiment := range r.Experiments {
+		if experiment.Experiment == nil {
+			continue
+		}
+		// Experiment names are workspace paths; prefix the last path component.
+		dir, base := path.Split(experiment.Name)
+		experiment.Name = dir + prefix + base
+		experiment.Tags = append(experiment.Tags, ml.ExperimentTag{Key: "dev", Value: shortName})
+	}
+
+	for _, endpoint := range r.ModelServingEndpoints {
+		if endpoint.CreateServingEndpoint == nil {
+			continue
+		}
+		endpoint.Name = identifierPrefix + endpoint.Name
+	}
+
+	for _, cluster := range r.Clusters {
+		if cluster.ClusterSpec == nil {
+			continue
+		}
+		cluster.ClusterName = prefix + cluster.ClusterName
+		if cluster.CustomTags == nil {
+			cluster.CustomTags = make(map[string]string)
+		}
+		cluster.CustomTags["dev"] = shortName
+	}
+
+	// Volumes are not renamed; they are isolated through the schema they are created in.
+	for _, schema := range r.Schemas {
+		if schema.CreateSchema == nil {
+			continue
+		}
+		schema.Name = identifierPrefix + schema.Name
+	}
+
+	return nil
+}
+
+func (m *processEnvironmentMode) Apply(ctx context.Context, b *bundle.Bundle) error {
+	switch b.Config.Bundle.Mode {
+	case config.Development:
+		return processDevelopmentMode(b)
+	case "":
+		// No action
+		return nil
+	default:
+		return fmt.Errorf("unsupported value for mode: %s", b.Config.Bundle.Mode)
+	}
+}
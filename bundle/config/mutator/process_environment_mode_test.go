@@ -0,0 +1,144 @@
+package mutator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/cli/bundle/config/mutator"
+	"github.com/databricks/cli/bundle/config/resources"
+	"github.com/databricks/databricks-sdk-go/service/catalog"
+	"github.com/databricks/databricks-sdk-go/service/compute"
+	"github.com/databricks/databricks-sdk-go/service/iam"
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+	"github.com/databricks/databricks-sdk-go/service/ml"
+	"github.com/databricks/databricks-sdk-go/service/pipelines"
+	"github.com/databricks/databricks-sdk-go/service/serving"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mockBundle(mode config.Mode) *bundle.Bundle {
+	return &bundle.Bundle{
+		Config: config.Root{
+			Bundle: config.Bundle{
+				Mode: mode,
+			},
+			Workspace: config.Workspace{
+				CurrentUser: &iam.User{
+					UserName: "jane.doe@example.com",
+				},
+			},
+			Resources: config.Resources{
+				Jobs: map[string]*resources.Job{
+					"job1": {
+						JobSettings: &jobs.JobSettings{
+							Name: "job1",
+							Schedule: &jobs.CronSchedule{
+								QuartzCronExpression: "0 0 * * * ?",
+								PauseStatus:          jobs.PauseStatusUnpaused,
+							},
+							Trigger: &jobs.TriggerSettings{
+								PauseStatus: jobs.PauseStatusUnpaused,
+							},
+						},
+					},
+				},
+				Pipelines: map[string]*resources.Pipeline{
+					"pipeline1": {PipelineSpec: &pipelines.PipelineSpec{Name: "pipeline1"}},
+					"pipeline2": {PipelineSpec: &pipelines.PipelineSpec{
+						Name: "pipeline2",
+						Clusters: []pipelines.PipelineCluster{
+							{Label: "default", CustomTags: map[string]string{"team": "data"}},
+							{Label: "maintenance"},
+						},
+					}},
+				},
+				Experiments: map[string]*resources.MlflowExperiment{
+					"experiment1": {Experiment: &ml.Experiment{Name: "/Users/jane.doe@example.com/experiment1"}},
+				},
+				Models: map[string]*resources.MlflowModel{
+					"model1": {Model: &ml.Model{Name: "model1"}},
+				},
+				ModelServingEndpoints: map[string]*resources.ModelServingEndpoint{
+					"endpoint1": {CreateServingEndpoint: &serving.CreateServingEndpoint{Name: "endpoint1"}},
+				},
+				Clusters: map[string]*resources.Cluster{
+					"cluster1": {ClusterSpec: &compute.ClusterSpec{ClusterName: "cluster1"}},
+				},
+				Schemas: map[string]*resources.Schema{
+					"schema1": {CreateSchema: &catalog.CreateSchema{Name: "schema1"}},
+				},
+			},
+		},
+	}
+}
+
+func TestProcessEnvironmentModeDevelopment(t *testing.T) {
+	bundle := mockBundle(config.Development)
+
+	err := mutator.ProcessEnvironmentMode().Apply(context.Background(), bundle)
+	require.NoError(t, err)
+
+	job := bundle.Config.Resources.Jobs["job1"]
+	assert.Equal(t, "[dev jane.doe] job1", job.Name)
+	assert.Equal(t, "jane.doe", job.Tags["dev"])
+	assert.Equal(t, jobs.PauseStatusPaused, job.Schedule.PauseStatus)
+	assert.Equal(t, jobs.PauseStatusPaused, job.Trigger.PauseStatus)
+
+	pipeline := bundle.Config.Resources.Pipelines["pipeline1"]
+	assert.Equal(t, "[dev jane.doe] pipeline1", pipeline.Name)
+	assert.True(t, pipeline.Development)
+	assert.Equal(t, []pipelines.PipelineCluster{
+		{Label: "default", CustomTags: map[string]string{"dev": "jane.doe"}},
+	}, pipeline.Clusters)
+
+	pipeline = bundle.Config.Resources.Pipelines["pipeline2"]
+	assert.Equal(t, map[string]string{"team": "data", "dev": "jane.doe"}, pipeline.Clusters[0].CustomTags)
+	assert.Equal(t, map[string]string{"dev": "jane.doe"}, pipeline.Clusters[1].CustomTags)
+
+	experiment := bundle.Config.Resources.Experiments["experiment1"]
+	assert.Equal(t, "/Users/jane.doe@example.com/[dev jane.doe] experiment1", experiment.Name)
+	assert.Equal(t, []ml.ExperimentTag{{Key: "dev", Value: "jane.doe"}}, experiment.Tags)
+
+	model := bundle.Config.Resources.Models["model1"]
+	assert.Equal(t, "[dev jane.doe] model1", model.Name)
+	assert.Equal(t, []ml.ModelTag{{Key: "dev", Value: "jane.doe"}}, model.Tags)
+
+	assert.Equal(t, "dev_jane_doe_endpoint1", bundle.Config.Resources.ModelServingEndpoints["endpoint1"].Name)
+
+	cluster := bundle.Config.Resources.Clusters["cluster1"]
+	assert.Equal(t, "[dev jane.doe] cluster1", cluster.ClusterName)
+	assert.Equal(t, "jane.doe", cluster.CustomTags["dev"])
+
+	assert.Equal(t, "dev_jane_doe_schema1", bundle.Config.Resources.Schemas["schema1"].Name)
+}
+
+func TestProcessEnvironmentModeDefault(t *testing.T) {
+	bundle := mockBundle("")
+
+	err := mutator.ProcessEnvironmentMode().Apply(context.Background(), bundle)
+	require.NoError(t, err)
+
+	job := bundle.Config.Resources.Jobs["job1"]
+	assert.Equal(t, "job1", job.Name)
+	assert.Nil(t, job.Tags)
+	assert.Equal(t, jobs.PauseStatusUnpaused, job.Schedule.PauseStatus)
+	assert.False(t, bundle.Config.Resources.Pipelines["pipeline1"].Development)
+}
+
+func TestProcessEnvironmentModeUnsupported(t *testing.T) {
+	bundle := mockBundle("production")
+
+	err := mutator.ProcessEnvironmentMode().Apply(context.Background(), bundle)
+	assert.ErrorContains(t, err, "unsupported value for mode: production")
+}
+
+func TestProcessEnvironmentModeDevelopmentWithoutCurrentUser(t *testing.T) {
+	bundle := mockBundle(config.Development)
+	bundle.Config.Workspace.CurrentUser = nil
+
+	err := mutator.ProcessEnvironmentMode().Apply(context.Background(), bundle)
+	assert.ErrorContains(t, err, "current user not set")
+}
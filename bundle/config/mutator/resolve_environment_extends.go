@@ -0,0 +1,23 @@
+package mutator
+
+import (
+	"context"
+
+	"github.com/databricks/cli/bundle"
+)
+
+type resolveEnvironmentExtends struct{}
+
+// ResolveEnvironmentExtends resolves the `extends` field of all environments
+// such that an environment can be merged together with the environments it extends.
+func ResolveEnvironmentExtends() bundle.Mutator {
+	return &resolveEnvironmentExtends{}
+}
+
+func (m *resolveEnvironmentExtends) Name() string {
+	return "ResolveEnvironmentExtends"
+}
+
+func (m *resolveEnvironmentExtends) Apply(_ context.Context, b *bundle.Bundle) error {
+	return b.Config.ResolveEnvironmentExtends()
+}
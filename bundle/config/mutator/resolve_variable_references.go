@@ -0,0 +1,23 @@
+package mutator
+
+import (
+	"context"
+
+	"github.com/databricks/cli/bundle"
+)
+
+type resolveVariableReferences struct{}
+
+// ResolveVariableReferences assigns variable values to fields that reference
+// a variable in their entirety and cannot hold a string (e.g. `num_workers: ${var.workers}`).
+func ResolveVariableReferences() bundle.Mutator {
+	return &resolveVariableReferences{}
+}
+
+func (m *resolveVariableReferences) Name() string {
+	return "ResolveVariableReferences"
+}
+
+func (m *resolveVariableReferences) Apply(_ context.Context, b *bundle.Bundle) error {
+	return b.Config.ResolveVariableReferences()
+}
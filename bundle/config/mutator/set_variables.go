@@ -7,6 +7,8 @@ import (
 
 	"github.com/databricks/cli/bundle"
 	"github.com/databricks/cli/bundle/config/variable"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/databricks-sdk-go"
 )
 
 const bundleVarPrefix = "BUNDLE_VAR_"
@@ -21,7 +23,29 @@ func (m *setVariables) Name() string {
 	return "SetVariables"
 }
 
-func setVariable(v *variable.Variable, name string) error {
+func promptVariable(ctx context.Context, v *variable.Variable, name string) error {
+	prompt := cmdio.StderrPrompt(ctx)
+	prompt.Label = name
+	if v.Description != "" {
+		prompt.Label = v.Description
+	}
+	if v.Sensitive {
+		prompt.Mask = '*'
+	}
+
+	val, err := prompt.Run()
+	if err != nil {
+		return fmt.Errorf("failed to read value for variable %s: %w", name, err)
+	}
+
+	err = v.Set(val)
+	if err != nil {
+		return fmt.Errorf(`failed to assign value to variable %s with error: %w`, name, err)
+	}
+	return nil
+}
+
+func setVariable(ctx context.Context, v *variable.Variable, name string) error {
 	// case: variable already has value initialized, so skip
 	if v.HasValue() {
 		return nil
@@ -39,24 +63,52 @@ func setVariable(v *variable.Variable, name string) error {
 
 	// case: Set the variable to its default value
 	if v.HasDefault() {
-		err := v.Set(*v.Default)
+		err := v.SetValue(v.Default)
 		if err != nil {
-			return fmt.Errorf(`failed to assign default value from config "%s" to variable %s with error: %w`, *v.Default, name, err)
+			return fmt.Errorf(`failed to assign default value from config "%s" to variable %s with error: %w`, variable.ToString(v.Default), name, err)
 		}
 		return nil
 	}
 
+	// case: Defer to the lookup, which requires a workspace client.
+	// Lookups are resolved after all other variables have been set.
+	if v.HasLookup() {
+		return nil
+	}
+
+	// case: Ask the user for a value if the current terminal is a tty.
+	if cmdio.IsPromptSupported(ctx) {
+		return promptVariable(ctx, v, name)
+	}
+
 	// We should have had a value to set for the variable at this point.
-	// TODO: use cmdio to request values for unassigned variables if current
-	// terminal is a tty. Tracked in https://github.com/databricks/cli/issues/379
 	return fmt.Errorf(`no value assigned to required variable %s. Assignment can be done through the "--var" flag or by setting the %s environment variable`, name, bundleVarPrefix+name)
 }
 
+func resolveLookup(ctx context.Context, w *databricks.WorkspaceClient, v *variable.Variable, name string) error {
+	id, err := v.Lookup.Resolve(ctx, w)
+	if err != nil {
+		return fmt.Errorf(`failed to resolve lookup "%s" for variable %s: %w`, v.Lookup, name, err)
+	}
+	return v.Set(id)
+}
+
 func (m *setVariables) Apply(ctx context.Context, b *bundle.Bundle) error {
 	for name, variable := range b.Config.Variables {
-		err := setVariable(variable, name)
+		err := setVariable(ctx, variable, name)
+		if err != nil {
+			return b.Config.ErrorAt("variables."+name, err)
+		}
+	}
+
+	// Resolve lookups for variables that didn't get a value through other means.
+	for name, variable := range b.Config.Variables {
+		if variable.HasValue() || !variable.HasLookup() {
+			continue
+		}
+		err := resolveLookup(ctx, b.WorkspaceClient(), variable, name)
 		if err != nil {
-			return err
+			return b.Config.ErrorAt("variables."+name+".lookup", err)
 		}
 	}
 	return nil
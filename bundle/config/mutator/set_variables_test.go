@@ -2,11 +2,15 @@ package mutator
 
 import (
 	"context"
+	"io"
+	"strings"
 	"testing"
 
 	"github.com/databricks/cli/bundle"
 	"github.com/databricks/cli/bundle/config"
 	"github.com/databricks/cli/bundle/config/variable"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/flags"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -15,27 +19,27 @@ func TestSetVariableFromProcessEnvVar(t *testing.T) {
 	defaultVal := "default"
 	variable := variable.Variable{
 		Description: "a test variable",
-		Default:     &defaultVal,
+		Default:     defaultVal,
 	}
 
 	// set value for variable as an environment variable
 	t.Setenv("BUNDLE_VAR_foo", "process-env")
 
-	err := setVariable(&variable, "foo")
+	err := setVariable(context.Background(), &variable, "foo")
 	require.NoError(t, err)
-	assert.Equal(t, *variable.Value, "process-env")
+	assert.Equal(t, variable.Value, "process-env")
 }
 
 func TestSetVariableUsingDefaultValue(t *testing.T) {
 	defaultVal := "default"
 	variable := variable.Variable{
 		Description: "a test variable",
-		Default:     &defaultVal,
+		Default:     defaultVal,
 	}
 
-	err := setVariable(&variable, "foo")
+	err := setVariable(context.Background(), &variable, "foo")
 	require.NoError(t, err)
-	assert.Equal(t, *variable.Value, "default")
+	assert.Equal(t, variable.Value, "default")
 }
 
 func TestSetVariableWhenAlreadyAValueIsAssigned(t *testing.T) {
@@ -43,15 +47,15 @@ func TestSetVariableWhenAlreadyAValueIsAssigned(t *testing.T) {
 	val := "assigned-value"
 	variable := variable.Variable{
 		Description: "a test variable",
-		Default:     &defaultVal,
-		Value:       &val,
+		Default:     defaultVal,
+		Value:       val,
 	}
 
 	// since a value is already assigned to the variable, it would not be overridden
 	// by the default value
-	err := setVariable(&variable, "foo")
+	err := setVariable(context.Background(), &variable, "foo")
 	require.NoError(t, err)
-	assert.Equal(t, *variable.Value, "assigned-value")
+	assert.Equal(t, variable.Value, "assigned-value")
 }
 
 func TestSetVariableEnvVarValueDoesNotOverridePresetValue(t *testing.T) {
@@ -59,8 +63,8 @@ func TestSetVariableEnvVarValueDoesNotOverridePresetValue(t *testing.T) {
 	val := "assigned-value"
 	variable := variable.Variable{
 		Description: "a test variable",
-		Default:     &defaultVal,
-		Value:       &val,
+		Default:     defaultVal,
+		Value:       val,
 	}
 
 	// set value for variable as an environment variable
@@ -68,9 +72,9 @@ func TestSetVariableEnvVarValueDoesNotOverridePresetValue(t *testing.T) {
 
 	// since a value is already assigned to the variable, it would not be overridden
 	// by the value from environment
-	err := setVariable(&variable, "foo")
+	err := setVariable(context.Background(), &variable, "foo")
 	require.NoError(t, err)
-	assert.Equal(t, *variable.Value, "assigned-value")
+	assert.Equal(t, variable.Value, "assigned-value")
 }
 
 func TestSetVariablesErrorsIfAValueCouldNotBeResolved(t *testing.T) {
@@ -79,10 +83,35 @@ func TestSetVariablesErrorsIfAValueCouldNotBeResolved(t *testing.T) {
 	}
 
 	// fails because we could not resolve a value for the variable
-	err := setVariable(&variable, "foo")
+	// and cannot prompt for it when stdin is not a terminal
+	err := setVariable(context.Background(), &variable, "foo")
 	assert.ErrorContains(t, err, "no value assigned to required variable foo. Assignment can be done through the \"--var\" flag or by setting the BUNDLE_VAR_foo environment variable")
 }
 
+func TestSetVariableDefersToLookup(t *testing.T) {
+	variable := variable.Variable{
+		Description: "a variable resolved through a lookup",
+		Lookup:      &variable.Lookup{Cluster: "foo"},
+	}
+
+	// does not fail; the lookup is resolved by the mutator
+	err := setVariable(context.Background(), &variable, "foo")
+	require.NoError(t, err)
+	assert.False(t, variable.HasValue())
+}
+
+func TestSetVariableDefaultTakesPrecedenceOverLookup(t *testing.T) {
+	variable := variable.Variable{
+		Description: "a variable with a lookup that is assigned a value by an environment",
+		Default:     "default",
+		Lookup:      &variable.Lookup{Cluster: "foo"},
+	}
+
+	err := setVariable(context.Background(), &variable, "foo")
+	require.NoError(t, err)
+	assert.Equal(t, "default", variable.Value)
+}
+
 func TestSetVariablesMutator(t *testing.T) {
 	defaultValForA := "default-a"
 	defaultValForB := "default-b"
@@ -92,15 +121,15 @@ func TestSetVariablesMutator(t *testing.T) {
 			Variables: map[string]*variable.Variable{
 				"a": {
 					Description: "resolved to default value",
-					Default:     &defaultValForA,
+					Default:     defaultValForA,
 				},
 				"b": {
 					Description: "resolved from environment vairables",
-					Default:     &defaultValForB,
+					Default:     defaultValForB,
 				},
 				"c": {
 					Description: "has already been assigned a value",
-					Value:       &valForC,
+					Value:       valForC,
 				},
 			},
 		},
@@ -110,7 +139,36 @@ func TestSetVariablesMutator(t *testing.T) {
 
 	err := SetVariables().Apply(context.Background(), bundle)
 	require.NoError(t, err)
-	assert.Equal(t, "default-a", *bundle.Config.Variables["a"].Value)
-	assert.Equal(t, "env-var-b", *bundle.Config.Variables["b"].Value)
-	assert.Equal(t, "assigned-val-c", *bundle.Config.Variables["c"].Value)
+	assert.Equal(t, "default-a", bundle.Config.Variables["a"].Value)
+	assert.Equal(t, "env-var-b", bundle.Config.Variables["b"].Value)
+	assert.Equal(t, "assigned-val-c", bundle.Config.Variables["c"].Value)
+}
+
+func TestPromptVariable(t *testing.T) {
+	var stdout, stderr strings.Builder
+	cmdIO := cmdio.NewIO(flags.OutputText, strings.NewReader("bar\n"), &stdout, &stderr, "")
+	ctx := cmdio.InContext(context.Background(), cmdIO)
+
+	v := variable.Variable{
+		Description: "a test variable",
+	}
+	err := promptVariable(ctx, &v, "foo")
+	require.NoError(t, err)
+	assert.Equal(t, "bar", v.Value)
+
+	// The prompt is written to stderr to not mix with command output.
+	assert.Empty(t, stdout.String())
+	assert.Contains(t, stderr.String(), "a test variable")
+}
+
+func TestPromptVariableWithComplexType(t *testing.T) {
+	cmdIO := cmdio.NewIO(flags.OutputText, strings.NewReader(`["a","b"]`+"\n"), io.Discard, io.Discard, "")
+	ctx := cmdio.InContext(context.Background(), cmdIO)
+
+	v := variable.Variable{
+		Type: variable.VariableTypeList,
+	}
+	err := promptVariable(ctx, &v, "foo")
+	require.NoError(t, err)
+	assert.Equal(t, []any{"a", "b"}, v.Value)
 }
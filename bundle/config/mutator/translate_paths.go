@@ -2,14 +2,19 @@ package mutator
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"net/url"
 	"os"
 	"path"
 	"path/filepath"
 	"strings"
 
 	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config"
 	"github.com/databricks/cli/libs/notebook"
+	"github.com/databricks/databricks-sdk-go/service/compute"
 	"github.com/databricks/databricks-sdk-go/service/jobs"
 	"github.com/databricks/databricks-sdk-go/service/pipelines"
 )
@@ -19,6 +24,7 @@ type translatePaths struct {
 }
 
 // TranslatePaths converts paths to local notebook files into paths in the workspace file system.
+// Local library files of job tasks are turned into artifacts that are uploaded on deploy.
 func TranslatePaths() bundle.Mutator {
 	return &translatePaths{}
 }
@@ -105,18 +111,111 @@ func (m *translatePaths) translateFilePath(literal, localPath, remotePath string
 	return remotePath, nil
 }
 
-func (m *translatePaths) translateJobTask(dir string, b *bundle.Bundle, task *jobs.Task) error {
+// libraryArtifactKey returns the key of the artifact for a local library file.
+// The argument `relPath` is the path of the file relative to the bundle root.
+// The key only contains characters that can be used in variable references,
+// and includes a hash of the path so that keys of different paths never collide.
+func libraryArtifactKey(relPath string) string {
+	parts := strings.FieldsFunc(relPath, func(r rune) bool {
+		return !('a' <= r && r <= 'z' || 'A' <= r && r <= 'Z' || '0' <= r && r <= '9')
+	})
+	sum := sha256.Sum256([]byte(filepath.ToSlash(relPath)))
+	parts = append(parts, hex.EncodeToString(sum[:])[:8])
+	return strings.Join(append([]string{"library"}, parts...), "_")
+}
+
+// escapeGlob escapes the special characters of a glob pattern in a file name
+// such that the pattern only matches that file.
+func escapeGlob(name string) string {
+	var b strings.Builder
+	for _, r := range name {
+		switch r {
+		case '*', '?', '[':
+			b.WriteString("[" + string(r) + "]")
+		case '\\':
+			// File names only contain a backslash where it is not a path separator,
+			// which is also where it escapes the next character in a pattern.
+			b.WriteString(`\\`)
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// translateLibraryPath returns a function that defines an artifact for a local library file.
+// The library path is rewritten to refer to the remote path of the artifact,
+// which is resolved after the artifact has been built.
+func (m *translatePaths) translateLibraryPath(b *bundle.Bundle) func(literal, localPath, remotePath string) (string, error) {
+	return func(literal, localPath, _ string) (string, error) {
+		info, err := os.Stat(localPath)
+		if os.IsNotExist(err) {
+			return "", fmt.Errorf("library %s not found", literal)
+		}
+		if err != nil {
+			return "", fmt.Errorf("unable to access %s: %w", localPath, err)
+		}
+		if info.IsDir() {
+			return "", fmt.Errorf("library %s is a directory", literal)
+		}
+
+		relPath, err := filepath.Rel(b.Config.Path, localPath)
+		if err != nil {
+			return "", err
+		}
+
+		key := libraryArtifactKey(relPath)
+		if _, ok := b.Config.Artifacts[key]; ok {
+			return "", fmt.Errorf("artifact %s for library %s is already defined", key, literal)
+		}
+		if b.Config.Artifacts == nil {
+			b.Config.Artifacts = make(map[string]*config.Artifact)
+		}
+		b.Config.Artifacts[key] = &config.Artifact{
+			Files: &config.FilesArtifact{
+				Path:  filepath.ToSlash(filepath.Dir(relPath)),
+				Files: escapeGlob(filepath.Base(relPath)),
+			},
+		}
+
+		return fmt.Sprintf("${artifacts.%s.remote_path}", key), nil
+	}
+}
+
+// isLocalLibraryPath returns true if the library path refers to a local file.
+// Absolute paths, URLs (e.g. dbfs:/ or s3://), and variable references are not local.
+func isLocalLibraryPath(p string) bool {
+	if p == "" || strings.Contains(p, "${") {
+		return false
+	}
+	u, err := url.Parse(p)
+	if err == nil && u.Scheme != "" {
+		return false
+	}
+	return true
+}
+
+// translateJobTask translates the paths in a job task.
+// The argument `configPath` is the path of the task in the configuration tree.
+func (m *translatePaths) translateJobTask(dir string, b *bundle.Bundle, task *jobs.Task, configPath string) error {
 	var err error
 
 	if task.NotebookTask != nil {
 		err = m.rewritePath(dir, b, &task.NotebookTask.NotebookPath, m.translateNotebookPath)
 		if err != nil {
-			return err
+			return b.Config.ErrorAt(configPath+".notebook_task.notebook_path", err)
 		}
 	}
 
 	if task.SparkPythonTask != nil {
 		err = m.rewritePath(dir, b, &task.SparkPythonTask.PythonFile, m.translateFilePath)
+		if err != nil {
+			return b.Config.ErrorAt(configPath+".spark_python_task.python_file", err)
+		}
+	}
+
+	for i := range task.Libraries {
+		err = m.translateJobLibrary(dir, b, &task.Libraries[i], fmt.Sprintf("%s.libraries[%d]", configPath, i))
 		if err != nil {
 			return err
 		}
@@ -125,20 +224,45 @@ func (m *translatePaths) translateJobTask(dir string, b *bundle.Bundle, task *jo
 	return nil
 }
 
-func (m *translatePaths) translatePipelineLibrary(dir string, b *bundle.Bundle, library *pipelines.PipelineLibrary) error {
+// translateJobLibrary translates the paths in a job task library.
+// The argument `configPath` is the path of the library in the configuration tree.
+func (m *translatePaths) translateJobLibrary(dir string, b *bundle.Bundle, library *compute.Library, configPath string) error {
+	for _, field := range []struct {
+		name string
+		p    *string
+	}{
+		{"whl", &library.Whl},
+		{"jar", &library.Jar},
+		{"egg", &library.Egg},
+	} {
+		if !isLocalLibraryPath(*field.p) {
+			continue
+		}
+		err := m.rewritePath(dir, b, field.p, m.translateLibraryPath(b))
+		if err != nil {
+			return b.Config.ErrorAt(configPath+"."+field.name, err)
+		}
+	}
+
+	return nil
+}
+
+// translatePipelineLibrary translates the paths in a pipeline library.
+// The argument `configPath` is the path of the library in the configuration tree.
+func (m *translatePaths) translatePipelineLibrary(dir string, b *bundle.Bundle, library *pipelines.PipelineLibrary, configPath string) error {
 	var err error
 
 	if library.Notebook != nil {
 		err = m.rewritePath(dir, b, &library.Notebook.Path, m.translateNotebookPath)
 		if err != nil {
-			return err
+			return b.Config.ErrorAt(configPath+".notebook.path", err)
 		}
 	}
 
 	if library.File != nil {
 		err = m.rewritePath(dir, b, &library.File.Path, m.translateFilePath)
 		if err != nil {
-			return err
+			return b.Config.ErrorAt(configPath+".file.path", err)
 		}
 	}
 
@@ -160,7 +284,7 @@ func (m *translatePaths) Apply(_ context.Context, b *bundle.Bundle) error {
 		}
 
 		for i := 0; i < len(job.Tasks); i++ {
-			err := m.translateJobTask(dir, b, &job.Tasks[i])
+			err := m.translateJobTask(dir, b, &job.Tasks[i], fmt.Sprintf("resources.jobs.%s.tasks[%d]", key, i))
 			if err != nil {
 				return err
 			}
@@ -174,7 +298,7 @@ func (m *translatePaths) Apply(_ context.Context, b *bundle.Bundle) error {
 		}
 
 		for i := 0; i < len(pipeline.Libraries); i++ {
-			err := m.translatePipelineLibrary(dir, b, &pipeline.Libraries[i])
+			err := m.translatePipelineLibrary(dir, b, &pipeline.Libraries[i], fmt.Sprintf("resources.pipelines.%s.libraries[%d]", key, i))
 			if err != nil {
 				return err
 			}
@@ -10,6 +10,7 @@ import (
 	"github.com/databricks/cli/bundle/config"
 	"github.com/databricks/cli/bundle/config/mutator"
 	"github.com/databricks/cli/bundle/config/resources"
+	"github.com/databricks/databricks-sdk-go/service/compute"
 	"github.com/databricks/databricks-sdk-go/service/jobs"
 	"github.com/databricks/databricks-sdk-go/service/pipelines"
 	"github.com/stretchr/testify/assert"
@@ -233,6 +234,121 @@ func TestTranslatePaths(t *testing.T) {
 	)
 }
 
+func TestTranslatePathsJobLibraries(t *testing.T) {
+	dir := t.TempDir()
+	touchEmptyFile(t, filepath.Join(dir, "dist", "my_lib-0.0.1-py3-none-any.whl"))
+	touchEmptyFile(t, filepath.Join(dir, "job", "target", "app.jar"))
+
+	bundle := &bundle.Bundle{
+		Config: config.Root{
+			Path: dir,
+			Workspace: config.Workspace{
+				FilesPath: "/bundle",
+			},
+			Resources: config.Resources{
+				Jobs: map[string]*resources.Job{
+					"job": {
+						Paths: resources.Paths{
+							ConfigFilePath: filepath.Join(dir, "job", "resource.yml"),
+						},
+						JobSettings: &jobs.JobSettings{
+							Tasks: []jobs.Task{
+								{
+									Libraries: []compute.Library{
+										{Whl: "../dist/my_lib-0.0.1-py3-none-any.whl"},
+										{Jar: "./target/app.jar"},
+										{Whl: "dbfs:/FileStore/wheels/other.whl"},
+										{Jar: "/Workspace/Users/jane.doe@databricks.com/other.jar"},
+										{Whl: "${artifacts.my_wheel.remote_path}"},
+										{Pypi: &compute.PythonPyPiLibrary{Package: "requests"}},
+									},
+								},
+								{
+									Libraries: []compute.Library{
+										{Whl: "../dist/my_lib-0.0.1-py3-none-any.whl"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	err := mutator.TranslatePaths().Apply(context.Background(), bundle)
+	require.NoError(t, err)
+
+	// Assert that local libraries refer to the artifacts defined for them.
+	libraries := bundle.Config.Resources.Jobs["job"].Tasks[0].Libraries
+	assert.Equal(t, "${artifacts.library_dist_my_lib_0_0_1_py3_none_any_whl_4fbd1688.remote_path}", libraries[0].Whl)
+	assert.Equal(t, "${artifacts.library_job_target_app_jar_b5f9b7d7.remote_path}", libraries[1].Jar)
+	assert.Equal(t, "${artifacts.library_dist_my_lib_0_0_1_py3_none_any_whl_4fbd1688.remote_path}", bundle.Config.Resources.Jobs["job"].Tasks[1].Libraries[0].Whl)
+	assert.Equal(t, &config.FilesArtifact{
+		Path:  "dist",
+		Files: "my_lib-0.0.1-py3-none-any.whl",
+	}, bundle.Config.Artifacts["library_dist_my_lib_0_0_1_py3_none_any_whl_4fbd1688"].Files)
+	assert.Equal(t, &config.FilesArtifact{
+		Path:  "job/target",
+		Files: "app.jar",
+	}, bundle.Config.Artifacts["library_job_target_app_jar_b5f9b7d7"].Files)
+	assert.Len(t, bundle.Config.Artifacts, 2)
+
+	// Assert that other libraries are left alone.
+	assert.Equal(t, "dbfs:/FileStore/wheels/other.whl", libraries[2].Whl)
+	assert.Equal(t, "/Workspace/Users/jane.doe@databricks.com/other.jar", libraries[3].Jar)
+	assert.Equal(t, "${artifacts.my_wheel.remote_path}", libraries[4].Whl)
+}
+
+func TestTranslatePathsJobLibrariesWithSimilarNames(t *testing.T) {
+	dir := t.TempDir()
+	touchEmptyFile(t, filepath.Join(dir, "dist", "my-lib.whl"))
+	touchEmptyFile(t, filepath.Join(dir, "dist", "my_lib.whl"))
+	touchEmptyFile(t, filepath.Join(dir, "dist", "lib[1].whl"))
+
+	bundle := &bundle.Bundle{
+		Config: config.Root{
+			Path: dir,
+			Resources: config.Resources{
+				Jobs: map[string]*resources.Job{
+					"job": {
+						Paths: resources.Paths{
+							ConfigFilePath: filepath.Join(dir, "resource.yml"),
+						},
+						JobSettings: &jobs.JobSettings{
+							Tasks: []jobs.Task{
+								{
+									Libraries: []compute.Library{
+										{Whl: "./dist/my-lib.whl"},
+										{Whl: "./dist/my_lib.whl"},
+										{Whl: "./dist/lib[1].whl"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	err := mutator.TranslatePaths().Apply(context.Background(), bundle)
+	require.NoError(t, err)
+
+	// Assert that the keys of the artifacts are unique.
+	libraries := bundle.Config.Resources.Jobs["job"].Tasks[0].Libraries
+	assert.Equal(t, "${artifacts.library_dist_my_lib_whl_c6f06f29.remote_path}", libraries[0].Whl)
+	assert.Equal(t, "${artifacts.library_dist_my_lib_whl_024602c5.remote_path}", libraries[1].Whl)
+	assert.Equal(t, "${artifacts.library_dist_lib_1_whl_236e3851.remote_path}", libraries[2].Whl)
+	assert.Len(t, bundle.Config.Artifacts, 3)
+
+	// Assert that the pattern of a file name with special characters only matches that file.
+	artifact := bundle.Config.Artifacts["library_dist_lib_1_whl_236e3851"].Files
+	matches, err := filepath.Glob(filepath.Join(dir, artifact.Path, artifact.Files))
+	require.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join(dir, "dist", "lib[1].whl")}, matches)
+}
+
 func TestTranslatePathsInSubdirectories(t *testing.T) {
 	dir := t.TempDir()
 	touchEmptyFile(t, filepath.Join(dir, "job", "my_python_file.py"))
@@ -455,3 +571,34 @@ func TestPipelineFileDoesNotExistError(t *testing.T) {
 	err := mutator.TranslatePaths().Apply(context.Background(), bundle)
 	assert.EqualError(t, err, "file ./doesnt_exist.py not found")
 }
+
+func TestJobLibraryDoesNotExistError(t *testing.T) {
+	dir := t.TempDir()
+
+	bundle := &bundle.Bundle{
+		Config: config.Root{
+			Path: dir,
+			Resources: config.Resources{
+				Jobs: map[string]*resources.Job{
+					"job": {
+						Paths: resources.Paths{
+							ConfigFilePath: filepath.Join(dir, "fake.yml"),
+						},
+						JobSettings: &jobs.JobSettings{
+							Tasks: []jobs.Task{
+								{
+									Libraries: []compute.Library{
+										{Whl: "./dist/doesnt_exist.whl"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	err := mutator.TranslatePaths().Apply(context.Background(), bundle)
+	assert.EqualError(t, err, "library ./dist/doesnt_exist.whl not found")
+}
@@ -0,0 +1,45 @@
+package mutator
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/databricks/cli/bundle"
+)
+
+type validateRunAs struct{}
+
+// ValidateRunAs checks that the `run_as` setting of the bundle is well formed
+// and that all resources in the bundle are able to honor it.
+func ValidateRunAs() bundle.Mutator {
+	return &validateRunAs{}
+}
+
+func (m *validateRunAs) Name() string {
+	return "ValidateRunAs"
+}
+
+func (m *validateRunAs) Apply(_ context.Context, b *bundle.Bundle) error {
+	runAs := b.Config.RunAs
+	if runAs == nil {
+		return nil
+	}
+
+	if (runAs.UserName == "") == (runAs.ServicePrincipalName == "") {
+		return fmt.Errorf("run_as must specify exactly one of user_name or service_principal_name")
+	}
+
+	// Pipelines always run as their owner; they cannot run as another identity.
+	if len(b.Config.Resources.Pipelines) > 0 {
+		var keys []string
+		for k := range b.Config.Resources.Pipelines {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		return fmt.Errorf("run_as is not supported for pipelines (%s)", strings.Join(keys, ", "))
+	}
+
+	return nil
+}
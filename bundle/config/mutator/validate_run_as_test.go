@@ -0,0 +1,68 @@
+package mutator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/cli/bundle/config/mutator"
+	"github.com/databricks/cli/bundle/config/resources"
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateRunAs(t *testing.T) {
+	bundle := &bundle.Bundle{
+		Config: config.Root{
+			RunAs: &jobs.JobRunAs{
+				ServicePrincipalName: "my-service-principal",
+			},
+			Resources: config.Resources{
+				Jobs: map[string]*resources.Job{
+					"job1": {},
+				},
+			},
+		},
+	}
+
+	err := mutator.ValidateRunAs().Apply(context.Background(), bundle)
+	assert.NoError(t, err)
+}
+
+func TestValidateRunAsRequiresSingleIdentity(t *testing.T) {
+	bundle := &bundle.Bundle{
+		Config: config.Root{
+			RunAs: &jobs.JobRunAs{
+				UserName:             "jane@doe.com",
+				ServicePrincipalName: "my-service-principal",
+			},
+		},
+	}
+
+	err := mutator.ValidateRunAs().Apply(context.Background(), bundle)
+	assert.ErrorContains(t, err, "run_as must specify exactly one of user_name or service_principal_name")
+
+	bundle.Config.RunAs = &jobs.JobRunAs{}
+	err = mutator.ValidateRunAs().Apply(context.Background(), bundle)
+	assert.ErrorContains(t, err, "run_as must specify exactly one of user_name or service_principal_name")
+}
+
+func TestValidateRunAsRejectsPipelines(t *testing.T) {
+	bundle := &bundle.Bundle{
+		Config: config.Root{
+			RunAs: &jobs.JobRunAs{
+				UserName: "jane@doe.com",
+			},
+			Resources: config.Resources{
+				Pipelines: map[string]*resources.Pipeline{
+					"pipeline2": {},
+					"pipeline1": {},
+				},
+			},
+		},
+	}
+
+	err := mutator.ValidateRunAs().Apply(context.Background(), bundle)
+	assert.ErrorContains(t, err, "run_as is not supported for pipelines (pipeline1, pipeline2)")
+}
@@ -0,0 +1,67 @@
+package mutator
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/cli/bundle/schema"
+	"gopkg.in/yaml.v3"
+)
+
+type validateUnknownFields struct{}
+
+// ValidateUnknownFields returns an error if any of the configuration files
+// of the bundle contains keys that are not part of the configuration schema.
+// Such keys are otherwise silently ignored when the configuration is loaded.
+func ValidateUnknownFields() bundle.Mutator {
+	return &validateUnknownFields{}
+}
+
+func (m *validateUnknownFields) Name() string {
+	return "ValidateUnknownFields"
+}
+
+func (m *validateUnknownFields) Apply(_ context.Context, b *bundle.Bundle) error {
+	// The list of includes has been expanded to the files that were loaded.
+	paths := []string{filepath.Join(b.Config.Path, config.FileName)}
+	for _, include := range b.Config.Include {
+		paths = append(paths, filepath.Join(b.Config.Path, include))
+	}
+
+	var messages []string
+	for _, path := range paths {
+		raw, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		var node yaml.Node
+		err = yaml.Unmarshal(raw, &node)
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+
+		rel, err := filepath.Rel(b.Config.Path, path)
+		if err != nil {
+			rel = path
+		}
+		fields, err := schema.UnknownFields(reflect.TypeOf(config.Root{}), &node)
+		if err != nil {
+			return err
+		}
+		for _, f := range fields {
+			messages = append(messages, fmt.Sprintf("%s:%d:%d: %s", rel, f.Line, f.Column, f))
+		}
+	}
+
+	if len(messages) > 0 {
+		return fmt.Errorf("unknown fields in bundle configuration:\n  %s", strings.Join(messages, "\n  "))
+	}
+	return nil
+}
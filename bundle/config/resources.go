@@ -13,6 +13,13 @@ type Resources struct {
 
 	Models      map[string]*resources.MlflowModel      `json:"models,omitempty"`
 	Experiments map[string]*resources.MlflowExperiment `json:"experiments,omitempty"`
+
+	ModelServingEndpoints map[string]*resources.ModelServingEndpoint `json:"model_serving_endpoints,omitempty"`
+
+	Schemas map[string]*resources.Schema `json:"schemas,omitempty"`
+	Volumes map[string]*resources.Volume `json:"volumes,omitempty"`
+
+	Clusters map[string]*resources.Cluster `json:"clusters,omitempty"`
 }
 
 type UniqueResourceIdTracker struct {
@@ -52,7 +59,7 @@ func (r *Resources) VerifyUniqueResourceIdentifiers() (*UniqueResourceIdTracker,
 	}
 	for k := range r.Jobs {
 		tracker.Type[k] = "job"
-		tracker.ConfigPath[k] = r.Jobs[k].ConfigFilePath
+		tracker.ConfigPath[k] = r.Jobs[k].ConfigFileLocation()
 	}
 	for k := range r.Pipelines {
 		if _, ok := tracker.Type[k]; ok {
@@ -61,11 +68,11 @@ func (r *Resources) VerifyUniqueResourceIdentifiers() (*UniqueResourceIdTracker,
 				tracker.Type[k],
 				tracker.ConfigPath[k],
 				"pipeline",
-				r.Pipelines[k].ConfigFilePath,
+				r.Pipelines[k].ConfigFileLocation(),
 			)
 		}
 		tracker.Type[k] = "pipeline"
-		tracker.ConfigPath[k] = r.Pipelines[k].ConfigFilePath
+		tracker.ConfigPath[k] = r.Pipelines[k].ConfigFileLocation()
 	}
 	for k := range r.Models {
 		if _, ok := tracker.Type[k]; ok {
@@ -74,11 +81,11 @@ func (r *Resources) VerifyUniqueResourceIdentifiers() (*UniqueResourceIdTracker,
 				tracker.Type[k],
 				tracker.ConfigPath[k],
 				"mlflow_model",
-				r.Models[k].ConfigFilePath,
+				r.Models[k].ConfigFileLocation(),
 			)
 		}
 		tracker.Type[k] = "mlflow_model"
-		tracker.ConfigPath[k] = r.Models[k].ConfigFilePath
+		tracker.ConfigPath[k] = r.Models[k].ConfigFileLocation()
 	}
 	for k := range r.Experiments {
 		if _, ok := tracker.Type[k]; ok {
@@ -87,11 +94,63 @@ func (r *Resources) VerifyUniqueResourceIdentifiers() (*UniqueResourceIdTracker,
 				tracker.Type[k],
 				tracker.ConfigPath[k],
 				"mlflow_experiment",
-				r.Experiments[k].ConfigFilePath,
+				r.Experiments[k].ConfigFileLocation(),
 			)
 		}
 		tracker.Type[k] = "mlflow_experiment"
-		tracker.ConfigPath[k] = r.Experiments[k].ConfigFilePath
+		tracker.ConfigPath[k] = r.Experiments[k].ConfigFileLocation()
+	}
+	for k := range r.ModelServingEndpoints {
+		if _, ok := tracker.Type[k]; ok {
+			return tracker, fmt.Errorf("multiple resources named %s (%s at %s, %s at %s)",
+				k,
+				tracker.Type[k],
+				tracker.ConfigPath[k],
+				"model_serving_endpoint",
+				r.ModelServingEndpoints[k].ConfigFileLocation(),
+			)
+		}
+		tracker.Type[k] = "model_serving_endpoint"
+		tracker.ConfigPath[k] = r.ModelServingEndpoints[k].ConfigFileLocation()
+	}
+	for k := range r.Schemas {
+		if _, ok := tracker.Type[k]; ok {
+			return tracker, fmt.Errorf("multiple resources named %s (%s at %s, %s at %s)",
+				k,
+				tracker.Type[k],
+				tracker.ConfigPath[k],
+				"schema",
+				r.Schemas[k].ConfigFileLocation(),
+			)
+		}
+		tracker.Type[k] = "schema"
+		tracker.ConfigPath[k] = r.Schemas[k].ConfigFileLocation()
+	}
+	for k := range r.Volumes {
+		if _, ok := tracker.Type[k]; ok {
+			return tracker, fmt.Errorf("multiple resources named %s (%s at %s, %s at %s)",
+				k,
+				tracker.Type[k],
+				tracker.ConfigPath[k],
+				"volume",
+				r.Volumes[k].ConfigFileLocation(),
+			)
+		}
+		tracker.Type[k] = "volume"
+		tracker.ConfigPath[k] = r.Volumes[k].ConfigFileLocation()
+	}
+	for k := range r.Clusters {
+		if _, ok := tracker.Type[k]; ok {
+			return tracker, fmt.Errorf("multiple resources named %s (%s at %s, %s at %s)",
+				k,
+				tracker.Type[k],
+				tracker.ConfigPath[k],
+				"cluster",
+				r.Clusters[k].ConfigFileLocation(),
+			)
+		}
+		tracker.Type[k] = "cluster"
+		tracker.ConfigPath[k] = r.Clusters[k].ConfigFileLocation()
 	}
 	return tracker, nil
 }
@@ -112,4 +171,49 @@ func (r *Resources) SetConfigFilePath(path string) {
 	for _, e := range r.Experiments {
 		e.ConfigFilePath = path
 	}
+	for _, e := range r.ModelServingEndpoints {
+		e.ConfigFilePath = path
+	}
+	for _, e := range r.Schemas {
+		e.ConfigFilePath = path
+	}
+	for _, e := range r.Volumes {
+		e.ConfigFilePath = path
+	}
+	for _, e := range r.Clusters {
+		e.ConfigFilePath = path
+	}
+}
+
+// SetConfigFilePositions sets the position of the definition of every resource
+// contained in this instance. The specified function returns the line and column
+// of the resource with the specified kind (e.g. "jobs") and key.
+func (r *Resources) SetConfigFilePositions(position func(kind, key string) (int, int)) {
+	set := func(p *resources.Paths, kind, key string) {
+		p.ConfigFileLine, p.ConfigFileColumn = position(kind, key)
+	}
+	for k, e := range r.Jobs {
+		set(&e.Paths, "jobs", k)
+	}
+	for k, e := range r.Pipelines {
+		set(&e.Paths, "pipelines", k)
+	}
+	for k, e := range r.Models {
+		set(&e.Paths, "models", k)
+	}
+	for k, e := range r.Experiments {
+		set(&e.Paths, "experiments", k)
+	}
+	for k, e := range r.ModelServingEndpoints {
+		set(&e.Paths, "model_serving_endpoints", k)
+	}
+	for k, e := range r.Schemas {
+		set(&e.Paths, "schemas", k)
+	}
+	for k, e := range r.Volumes {
+		set(&e.Paths, "volumes", k)
+	}
+	for k, e := range r.Clusters {
+		set(&e.Paths, "clusters", k)
+	}
 }
@@ -0,0 +1,12 @@
+package resources
+
+import "github.com/databricks/databricks-sdk-go/service/compute"
+
+type Cluster struct {
+	ID          string       `json:"id,omitempty" bundle:"readonly"`
+	Permissions []Permission `json:"permissions,omitempty"`
+
+	Paths
+
+	*compute.ClusterSpec
+}
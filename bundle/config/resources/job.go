@@ -6,6 +6,12 @@ type Job struct {
 	ID          string       `json:"id,omitempty" bundle:"readonly"`
 	Permissions []Permission `json:"permissions,omitempty"`
 
+	// Keys of tasks and job clusters to remove from this job.
+	// An environment can use these to remove tasks or job clusters
+	// that are defined in the root configuration.
+	RemoveTasks       []string `json:"remove_tasks,omitempty"`
+	RemoveJobClusters []string `json:"remove_job_clusters,omitempty"`
+
 	Paths
 
 	*jobs.JobSettings
@@ -3,6 +3,7 @@ package resources
 import "github.com/databricks/databricks-sdk-go/service/ml"
 
 type MlflowModel struct {
+	ID          string       `json:"id,omitempty" bundle:"readonly"`
 	Permissions []Permission `json:"permissions,omitempty"`
 
 	Paths
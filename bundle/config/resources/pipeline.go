@@ -6,6 +6,11 @@ type Pipeline struct {
 	ID          string       `json:"id,omitempty" bundle:"readonly"`
 	Permissions []Permission `json:"permissions,omitempty"`
 
+	// Labels of clusters to remove from this pipeline.
+	// An environment can use this to remove clusters that are
+	// defined in the root configuration.
+	RemoveClusters []string `json:"remove_clusters,omitempty"`
+
 	Paths
 
 	*pipelines.PipelineSpec
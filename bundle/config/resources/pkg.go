@@ -9,6 +9,20 @@ type Paths struct {
 	// ConfigFilePath holds the path to the configuration file that
 	// described the resource that this type is embedded in.
 	ConfigFilePath string `json:"-" bundle:"readonly"`
+
+	// ConfigFileLine and ConfigFileColumn hold the position of the
+	// resource definition in the configuration file, if known.
+	ConfigFileLine   int `json:"-" bundle:"readonly"`
+	ConfigFileColumn int `json:"-" bundle:"readonly"`
+}
+
+// ConfigFileLocation returns the location of the resource definition
+// formatted as "path:line:column", or just the path if the position is unknown.
+func (p *Paths) ConfigFileLocation() string {
+	if p.ConfigFileLine == 0 {
+		return p.ConfigFilePath
+	}
+	return fmt.Sprintf("%s:%d:%d", p.ConfigFilePath, p.ConfigFileLine, p.ConfigFileColumn)
 }
 
 func (p *Paths) ConfigFileDirectory() (string, error) {
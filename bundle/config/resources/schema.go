@@ -0,0 +1,12 @@
+package resources
+
+import "github.com/databricks/databricks-sdk-go/service/catalog"
+
+type Schema struct {
+	ID     string  `json:"id,omitempty" bundle:"readonly"`
+	Grants []Grant `json:"grants,omitempty"`
+
+	Paths
+
+	*catalog.CreateSchema
+}
@@ -4,11 +4,17 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
 	"strings"
 
+	"github.com/databricks/cli/bundle/config/resources"
 	"github.com/databricks/cli/bundle/config/variable"
+	"github.com/databricks/databricks-sdk-go/service/jobs"
 	"github.com/ghodss/yaml"
 	"github.com/imdario/mergo"
+	yamlv3 "gopkg.in/yaml.v3"
 )
 
 // FileName is the name of bundle configuration file.
@@ -45,11 +51,30 @@ type Root struct {
 	// to deploy in this bundle (e.g. jobs, pipelines, etc.).
 	Resources Resources `json:"resources,omitempty"`
 
+	// Permissions applied to all resources in this bundle that support them.
+	// They are merged with the permissions defined on individual resources, which
+	// take precedence if they apply to the same principal.
+	// Supported levels are CAN_VIEW, CAN_RUN and CAN_MANAGE, which are mapped
+	// to the equivalent level of each resource type.
+	Permissions []resources.Permission `json:"permissions,omitempty"`
+
+	// RunAs specifies the identity that jobs in this bundle run as.
+	// It applies to all jobs that don't specify their own `run_as` setting.
+	RunAs *jobs.JobRunAs `json:"run_as,omitempty"`
+
 	// Environments can be used to differentiate settings and resources between
 	// bundle deployment environments (e.g. development, staging, production).
 	// If not specified, the code below initializes this field with a
 	// single default-initialized environment called "default".
 	Environments map[string]*Environment `json:"environments,omitempty"`
+
+	// References to variables in fields that cannot hold a string.
+	// These are assigned when variable values are resolved.
+	variableReferences []variableReference
+
+	// Locations of values in the configuration files.
+	// Also see [Root.Location].
+	locations []valueLocation
 }
 
 func Load(path string) (*Root, error) {
@@ -90,42 +115,175 @@ func (r *Root) SetConfigFilePath(path string) {
 
 // Initializes variables using values passed from the command line flag
 // Input has to be a string of the form `foo=bar`. In this case the variable with
-// name `foo` is assigned the value `bar`
+// name `foo` is assigned the value `bar`. Values of variables with a complex type
+// are parsed as JSON, for example `foo=["a", "b"]`.
+//
+// For compatibility with earlier versions, a single input can assign multiple
+// variables with a string type if the assignments are separated by a comma,
+// for example `foo=bar,baz=qux` (see [splitVariableAssignments]).
 func (r *Root) InitializeVariables(vars []string) error {
-	for _, variable := range vars {
-		parsedVariable := strings.SplitN(variable, "=", 2)
-		if len(parsedVariable) != 2 {
-			return fmt.Errorf("unexpected flag value for variable assignment: %s", variable)
+	for _, input := range vars {
+		assignments, err := r.splitVariableAssignments(input)
+		if err != nil {
+			return err
 		}
-		name := parsedVariable[0]
-		val := parsedVariable[1]
+		for _, parsedVariable := range assignments {
+			name := parsedVariable[0]
+			val := parsedVariable[1]
 
-		if _, ok := r.Variables[name]; !ok {
-			return fmt.Errorf("variable %s has not been defined", name)
-		}
-		err := r.Variables[name].Set(val)
-		if err != nil {
-			return fmt.Errorf("failed to assign %s to %s: %s", val, name, err)
+			if _, ok := r.Variables[name]; !ok {
+				return fmt.Errorf("variable %s has not been defined", name)
+			}
+			err := r.Variables[name].Set(val)
+			if err != nil {
+				return fmt.Errorf("failed to assign %s to %s: %s", val, name, err)
+			}
 		}
 	}
 	return nil
 }
 
+var variableAssignmentPrefix = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_-]*=`)
+
+// splitVariableAssignments splits an input of the form `foo=bar` into the name
+// and value of the variable to assign.
+//
+// The `--var` flag used to split its values on commas, such that `--var="a=1,b=2"`
+// assigned two variables. This is still the case if the value of a variable with a
+// string type is followed by a comma and another assignment. Other commas are retained,
+// such that `--var="a=1,2"` assigns `1,2` to `a`. Values of variables with a complex
+// type are never split, because they are parsed as JSON and may contain commas.
+func (r *Root) splitVariableAssignments(input string) ([][2]string, error) {
+	parsedVariable := strings.SplitN(input, "=", 2)
+	if len(parsedVariable) != 2 {
+		return nil, fmt.Errorf("unexpected flag value for variable assignment: %s", input)
+	}
+	name := parsedVariable[0]
+	val := parsedVariable[1]
+
+	if v, ok := r.Variables[name]; !ok || v.IsComplex() {
+		return [][2]string{{name, val}}, nil
+	}
+
+	parts := strings.Split(val, ",")
+	val = parts[0]
+	for i := 1; i < len(parts); i++ {
+		if variableAssignmentPrefix.MatchString(parts[i]) {
+			rest, err := r.splitVariableAssignments(strings.Join(parts[i:], ","))
+			if err != nil {
+				return nil, err
+			}
+			return append([][2]string{{name, val}}, rest...), nil
+		}
+		val += "," + parts[i]
+	}
+	return [][2]string{{name, val}}, nil
+}
+
 func (r *Root) Load(path string) error {
 	raw, err := os.ReadFile(path)
 	if err != nil {
 		return err
 	}
+
+	// Keep track of the location of every value in the file.
+	var node yamlv3.Node
+	err = yamlv3.Unmarshal(raw, &node)
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	locs := extractLocations(path, &node)
+
+	// Extract references to variables that make up the entire value of a field
+	// that isn't a string. These cannot be decoded into the typed configuration.
+	refs := extractVariableReferences(reflect.TypeOf(r), &node)
+	if len(refs) > 0 {
+		raw, err = yamlv3.Marshal(&node)
+		if err != nil {
+			return err
+		}
+	}
+
 	err = yaml.Unmarshal(raw, r)
 	if err != nil {
-		return err
+		return fmt.Errorf("%s: %w", path, err)
+	}
+
+	// References in environments are stored with the environment they are defined in.
+	for _, ref := range refs {
+		if ref.path[0] == "environments" {
+			env := r.Environments[ref.path[1].(string)]
+			env.variableReferences = append(env.variableReferences, variableReference{
+				path: ref.path[2:],
+				name: ref.name,
+			})
+			continue
+		}
+		r.variableReferences = append(r.variableReferences, ref)
+	}
+
+	// Locations in environments are stored with the environment they are defined in.
+	for _, loc := range locs {
+		if len(loc.path) > 2 && loc.path[0] == "environments" {
+			env := r.Environments[loc.path[1].(string)]
+			if env == nil {
+				continue
+			}
+			env.locations = append(env.locations, valueLocation{
+				path:     loc.path[2:],
+				location: loc.location,
+			})
+			continue
+		}
+		r.locations = append(r.locations, loc)
 	}
 
 	r.Path = filepath.Dir(path)
 	r.SetConfigFilePath(path)
+	r.Resources.SetConfigFilePositions(resourcePosition(r.locations))
+	for _, env := range r.Environments {
+		if env != nil && env.Resources != nil {
+			env.Resources.SetConfigFilePositions(resourcePosition(env.locations))
+		}
+	}
 
 	_, err = r.Resources.VerifyUniqueResourceIdentifiers()
-	return err
+	if err != nil {
+		return err
+	}
+
+	// Keyed elements are merged by key when an environment is merged (see [mergeByKey]),
+	// so their keys must be unique in the root and in every environment.
+	err = verifyUniqueKeys(&r.Resources, r.locations)
+	if err != nil {
+		return err
+	}
+	for _, env := range r.Environments {
+		if env != nil && env.Resources != nil {
+			err = verifyUniqueKeys(env.Resources, env.locations)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	// Verify variable definitions before values are assigned by environments.
+	names := make([]string, 0, len(r.Variables))
+	for name := range r.Variables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		v := r.Variables[name]
+		if v == nil {
+			continue
+		}
+		err = v.Validate()
+		if err != nil {
+			return r.ErrorAt("variables."+name, fmt.Errorf("invalid variable %s: %w", name, err))
+		}
+	}
+	return nil
 }
 
 func (r *Root) Merge(other *Root) error {
@@ -139,17 +297,101 @@ func (r *Root) Merge(other *Root) error {
 	}
 
 	// TODO: define and test semantics for merging.
-	return mergo.MergeWithOverwrite(r, other)
+	err = mergo.MergeWithOverwrite(r, other)
+	if err != nil {
+		return err
+	}
+
+	// Carry over references to variables from the other configuration.
+	r.pruneVariableReferences()
+	r.variableReferences = append(r.variableReferences, other.variableReferences...)
+	r.locations = append(r.locations, other.locations...)
+	for name, env := range other.Environments {
+		if env == nil || r.Environments[name] == env {
+			continue
+		}
+		r.Environments[name].variableReferences = append(r.Environments[name].variableReferences, env.variableReferences...)
+		r.Environments[name].locations = append(r.Environments[name].locations, env.locations...)
+	}
+
+	return nil
 }
 
-func (r *Root) MergeEnvironment(env *Environment) error {
-	var err error
+// ResolveEnvironmentExtends resolves the chain of environments that every
+// environment extends. It returns an error if an environment extends an
+// undefined environment or if the chain contains a cycle.
+func (r *Root) ResolveEnvironmentExtends() error {
+	// Iterate with stable ordering.
+	names := make([]string, 0, len(r.Environments))
+	for name := range r.Environments {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		env := r.Environments[name]
+		if env == nil {
+			continue
+		}
+
+		chain := []*Environment{}
+		seen := map[string]bool{name: true}
+		path := []string{name}
+		for cur := env; cur != nil && cur.Extends != ""; {
+			parentName := cur.Extends
+			path = append(path, parentName)
+			if seen[parentName] {
+				return fmt.Errorf("cycle in environment inheritance: %s", strings.Join(path, " -> "))
+			}
+			parent, ok := r.Environments[parentName]
+			if !ok {
+				return fmt.Errorf("environment %s extends undefined environment %s", path[len(path)-2], parentName)
+			}
+			seen[parentName] = true
+			if parent != nil {
+				chain = append([]*Environment{parent}, chain...)
+			}
+			cur = parent
+		}
+
+		env.extends = chain
+	}
+
+	return nil
+}
 
+// MergeEnvironment merges the specified environment into the root configuration.
+// The environments it extends are merged first, in order of inheritance.
+func (r *Root) MergeEnvironment(env *Environment) error {
 	// Environment may be nil if it's empty.
 	if env == nil {
 		return nil
 	}
 
+	if env.Extends != "" && env.extends == nil {
+		return fmt.Errorf("environment inheritance has not been resolved")
+	}
+
+	for _, parent := range env.extends {
+		err := r.mergeEnvironment(parent)
+		if err != nil {
+			return err
+		}
+	}
+
+	return r.mergeEnvironment(env)
+}
+
+func (r *Root) mergeEnvironment(env *Environment) error {
+	var err error
+
+	// Slice indices in variable references and locations are relative to the environment.
+	envVariableReferences := r.rebaseVariableReferences(env.variableReferences)
+	var envLocations []valueLocation
+	for _, loc := range env.locations {
+		envLocations = append(envLocations, valueLocation{path: r.rebasePath(loc.path), location: loc.location})
+	}
+
 	if env.Bundle != nil {
 		err = mergo.MergeWithOverwrite(&r.Bundle, env.Bundle)
 		if err != nil {
@@ -157,6 +399,10 @@ func (r *Root) MergeEnvironment(env *Environment) error {
 		}
 	}
 
+	if env.Mode != "" {
+		r.Bundle.Mode = env.Mode
+	}
+
 	if env.Workspace != nil {
 		err = mergo.MergeWithOverwrite(&r.Workspace, env.Workspace)
 		if err != nil {
@@ -172,23 +418,73 @@ func (r *Root) MergeEnvironment(env *Environment) error {
 	}
 
 	if env.Resources != nil {
-		err = mergo.Merge(&r.Resources, env.Resources, mergo.WithAppendSlice)
+		// Model serving endpoints are merged separately, see [Root.mergeModelServingEndpoints].
+		envResources := *env.Resources
+		envResources.ModelServingEndpoints = nil
+		err = mergo.Merge(&r.Resources, &envResources, mergo.WithAppendSlice)
+		if err != nil {
+			return err
+		}
+
+		err = r.mergeModelServingEndpoints(env.Resources.ModelServingEndpoints)
+		if err != nil {
+			return err
+		}
+
+		// Slices with keyed elements are merged by key instead of appended.
+		envVariableReferences, envLocations, err = r.mergeResourcesByKey(envVariableReferences, envLocations)
 		if err != nil {
 			return err
 		}
 	}
 
+	if env.RunAs != nil {
+		r.RunAs = env.RunAs
+	}
+
+	// Permissions in an environment are granted in addition to those in the root.
+	r.Permissions = append(r.Permissions, env.Permissions...)
+
 	if env.Variables != nil {
 		for k, v := range env.Variables {
 			variable, ok := r.Variables[k]
 			if !ok {
-				return fmt.Errorf("variable %s is not defined but is assigned a value", k)
+				return errorAt(env.locations, "variables."+k, fmt.Errorf("variable %s is not defined but is assigned a value", k))
 			}
 			// we only allow overrides of the default value for a variable
-			defaultVal := v
-			variable.Default = &defaultVal
+			variable.Default = v
 		}
 	}
 
+	// Fields assigned in the environment take precedence over references in the root.
+	r.pruneVariableReferences()
+	r.variableReferences = append(r.variableReferences, envVariableReferences...)
+	r.locations = append(r.locations, envLocations...)
+
+	return nil
+}
+
+// mergeModelServingEndpoints merges the model serving endpoints of an environment
+// into the root configuration. Unlike other resources, fields that are set in the
+// environment override those in the root, such that an environment can deploy an
+// endpoint under a different name or serve a different model version.
+func (r *Root) mergeModelServingEndpoints(endpoints map[string]*resources.ModelServingEndpoint) error {
+	for k, endpoint := range endpoints {
+		if endpoint == nil {
+			continue
+		}
+		if r.Resources.ModelServingEndpoints == nil {
+			r.Resources.ModelServingEndpoints = make(map[string]*resources.ModelServingEndpoint)
+		}
+		cur, ok := r.Resources.ModelServingEndpoints[k]
+		if !ok || cur == nil {
+			r.Resources.ModelServingEndpoints[k] = endpoint
+			continue
+		}
+		err := mergo.Merge(cur, endpoint, mergo.WithOverride, mergo.WithAppendSlice)
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
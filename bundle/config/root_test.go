@@ -5,7 +5,11 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/databricks/cli/bundle/config/resources"
 	"github.com/databricks/cli/bundle/config/variable"
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+	"github.com/databricks/databricks-sdk-go/service/pipelines"
+	"github.com/databricks/databricks-sdk-go/service/serving"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -79,7 +83,7 @@ func TestRootMergeMap(t *testing.T) {
 func TestDuplicateIdOnLoadReturnsError(t *testing.T) {
 	root := &Root{}
 	err := root.Load("./testdata/duplicate_resource_names_in_root/bundle.yml")
-	assert.ErrorContains(t, err, "multiple resources named foo (job at ./testdata/duplicate_resource_names_in_root/bundle.yml, pipeline at ./testdata/duplicate_resource_names_in_root/bundle.yml)")
+	assert.ErrorContains(t, err, "multiple resources named foo (job at ./testdata/duplicate_resource_names_in_root/bundle.yml:9:5, pipeline at ./testdata/duplicate_resource_names_in_root/bundle.yml:12:5)")
 }
 
 func TestDuplicateIdOnMergeReturnsError(t *testing.T) {
@@ -92,7 +96,25 @@ func TestDuplicateIdOnMergeReturnsError(t *testing.T) {
 	require.NoError(t, err)
 
 	err = root.Merge(other)
-	assert.ErrorContains(t, err, "multiple resources named foo (job at ./testdata/duplicate_resource_name_in_subconfiguration/bundle.yml, pipeline at ./testdata/duplicate_resource_name_in_subconfiguration/resources.yml)")
+	assert.ErrorContains(t, err, "multiple resources named foo (job at ./testdata/duplicate_resource_name_in_subconfiguration/bundle.yml:9:5, pipeline at ./testdata/duplicate_resource_name_in_subconfiguration/resources.yml:3:5)")
+}
+
+func TestDuplicateTaskKeyOnLoadReturnsError(t *testing.T) {
+	root := &Root{}
+	err := root.Load("./testdata/duplicate_task_keys_in_root/bundle.yml")
+	assert.ErrorContains(t, err, `./testdata/duplicate_task_keys_in_root/bundle.yml:15:11: job foo has multiple tasks with task_key "bar"`)
+}
+
+func TestDuplicateClusterLabelInEnvironmentOnLoadReturnsError(t *testing.T) {
+	root := &Root{}
+	err := root.Load("./testdata/duplicate_cluster_labels_in_environment/bundle.yml")
+	assert.ErrorContains(t, err, `./testdata/duplicate_cluster_labels_in_environment/bundle.yml:20:15: pipeline foo has multiple clusters with label "default"`)
+}
+
+func TestVariableWithDefaultAndLookupOnLoadReturnsError(t *testing.T) {
+	root := &Root{}
+	err := root.Load("./testdata/variable_with_default_and_lookup/bundle.yml")
+	assert.ErrorContains(t, err, "./testdata/variable_with_default_and_lookup/bundle.yml:5:3: invalid variable cluster_id: a variable cannot have both a default value and a lookup")
 }
 
 func TestInitializeVariables(t *testing.T) {
@@ -100,7 +122,7 @@ func TestInitializeVariables(t *testing.T) {
 	root := &Root{
 		Variables: map[string]*variable.Variable{
 			"foo": {
-				Default:     &fooDefault,
+				Default:     fooDefault,
 				Description: "an optional variable since default is defined",
 			},
 			"bar": {
@@ -111,8 +133,8 @@ func TestInitializeVariables(t *testing.T) {
 
 	err := root.InitializeVariables([]string{"foo=123", "bar=456"})
 	assert.NoError(t, err)
-	assert.Equal(t, "123", *(root.Variables["foo"].Value))
-	assert.Equal(t, "456", *(root.Variables["bar"].Value))
+	assert.Equal(t, "123", root.Variables["foo"].Value)
+	assert.Equal(t, "456", root.Variables["bar"].Value)
 }
 
 func TestInitializeVariablesWithAnEqualSignInValue(t *testing.T) {
@@ -126,7 +148,7 @@ func TestInitializeVariablesWithAnEqualSignInValue(t *testing.T) {
 
 	err := root.InitializeVariables([]string{"foo=123=567"})
 	assert.NoError(t, err)
-	assert.Equal(t, "123=567", *(root.Variables["foo"].Value))
+	assert.Equal(t, "123=567", root.Variables["foo"].Value)
 }
 
 func TestInitializeVariablesInvalidFormat(t *testing.T) {
@@ -154,3 +176,207 @@ func TestInitializeVariablesUndefinedVariables(t *testing.T) {
 	err := root.InitializeVariables([]string{"bar=567"})
 	assert.ErrorContains(t, err, "variable bar has not been defined")
 }
+
+func TestInitializeVariablesWithCommaSeparatedAssignments(t *testing.T) {
+	for _, tc := range []struct {
+		input string
+		foo   any
+		bar   any
+		err   string
+	}{
+		{input: "foo=123,bar=456", foo: "123", bar: "456"},
+		{input: "foo=1,2,bar=3,4", foo: "1,2", bar: "3,4"},
+		{input: "foo=hello, world", foo: "hello, world"},
+		{input: "foo=1,baz=2", err: "variable baz has not been defined"},
+		{input: "list=[1,2],foo=3", err: "failed to assign [1,2],foo=3 to list"},
+	} {
+		t.Run(tc.input, func(t *testing.T) {
+			root := &Root{
+				Variables: map[string]*variable.Variable{
+					"foo":  {},
+					"bar":  {},
+					"list": {Type: variable.VariableTypeList},
+				},
+			}
+
+			err := root.InitializeVariables([]string{tc.input})
+			if tc.err != "" {
+				assert.ErrorContains(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.foo, root.Variables["foo"].Value)
+			assert.Equal(t, tc.bar, root.Variables["bar"].Value)
+		})
+	}
+}
+
+func TestResolveEnvironmentExtendsUndefinedEnvironment(t *testing.T) {
+	root := &Root{
+		Environments: map[string]*Environment{
+			"prod": {
+				Extends: "staging",
+			},
+		},
+	}
+
+	err := root.ResolveEnvironmentExtends()
+	assert.ErrorContains(t, err, "environment prod extends undefined environment staging")
+}
+
+func TestResolveEnvironmentExtendsSelf(t *testing.T) {
+	root := &Root{
+		Environments: map[string]*Environment{
+			"prod": {
+				Extends: "prod",
+			},
+		},
+	}
+
+	err := root.ResolveEnvironmentExtends()
+	assert.ErrorContains(t, err, "cycle in environment inheritance: prod -> prod")
+}
+
+func TestMergeEnvironmentWithUnresolvedExtends(t *testing.T) {
+	root := &Root{}
+	err := root.MergeEnvironment(&Environment{Extends: "staging"})
+	assert.ErrorContains(t, err, "environment inheritance has not been resolved")
+}
+
+func TestMergeEnvironmentRetainsResourceFields(t *testing.T) {
+	root := &Root{
+		Resources: Resources{
+			Jobs: map[string]*resources.Job{
+				"job": {
+					JobSettings: &jobs.JobSettings{
+						Name:              "job",
+						MaxConcurrentRuns: 1,
+						Tags:              map[string]string{"team": "data"},
+					},
+				},
+			},
+			Pipelines: map[string]*resources.Pipeline{
+				"pipeline": {
+					PipelineSpec: &pipelines.PipelineSpec{
+						Name:   "pipeline",
+						Target: "dev",
+					},
+				},
+			},
+		},
+	}
+
+	err := root.MergeEnvironment(&Environment{
+		Resources: &Resources{
+			Jobs: map[string]*resources.Job{
+				"job": {
+					JobSettings: &jobs.JobSettings{
+						Name:           "[prod] job",
+						TimeoutSeconds: 3600,
+						Tags:           map[string]string{"team": "ops", "env": "prod"},
+					},
+				},
+			},
+			Pipelines: map[string]*resources.Pipeline{
+				"pipeline": {
+					PipelineSpec: &pipelines.PipelineSpec{
+						Target:  "prod",
+						Catalog: "main",
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	// Fields set in the root are retained.
+	job := root.Resources.Jobs["job"]
+	assert.Equal(t, "job", job.Name)
+	assert.Equal(t, 1, job.MaxConcurrentRuns)
+	pipeline := root.Resources.Pipelines["pipeline"]
+	assert.Equal(t, "dev", pipeline.Target)
+
+	// Fields not set in the root are taken from the environment.
+	assert.Equal(t, 3600, job.TimeoutSeconds)
+	assert.Equal(t, map[string]string{"team": "data", "env": "prod"}, job.Tags)
+	assert.Equal(t, "main", pipeline.Catalog)
+}
+
+func TestMergeEnvironmentOverridesModelServingEndpointFields(t *testing.T) {
+	root := &Root{
+		Resources: Resources{
+			ModelServingEndpoints: map[string]*resources.ModelServingEndpoint{
+				"endpoint": {
+					Permissions: []resources.Permission{{Level: "CAN_QUERY", GroupName: "users"}},
+					CreateServingEndpoint: &serving.CreateServingEndpoint{
+						Name: "endpoint",
+					},
+				},
+			},
+		},
+	}
+
+	err := root.MergeEnvironment(&Environment{
+		Resources: &Resources{
+			ModelServingEndpoints: map[string]*resources.ModelServingEndpoint{
+				"endpoint": {
+					Permissions: []resources.Permission{{Level: "CAN_MANAGE", GroupName: "admins"}},
+					CreateServingEndpoint: &serving.CreateServingEndpoint{
+						Name: "prod-endpoint",
+					},
+				},
+				"other": {
+					CreateServingEndpoint: &serving.CreateServingEndpoint{
+						Name: "other",
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	endpoint := root.Resources.ModelServingEndpoints["endpoint"]
+	assert.Equal(t, "prod-endpoint", endpoint.Name)
+	assert.Len(t, endpoint.Permissions, 2)
+	assert.Equal(t, "other", root.Resources.ModelServingEndpoints["other"].Name)
+}
+
+func TestMergeEnvironmentCannotResetFieldsToZeroValue(t *testing.T) {
+	root := &Root{
+		Resources: Resources{
+			Pipelines: map[string]*resources.Pipeline{
+				"pipeline": {
+					PipelineSpec: &pipelines.PipelineSpec{
+						Development: true,
+						Clusters: []pipelines.PipelineCluster{
+							{Label: "default", NumWorkers: 2},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	err := root.MergeEnvironment(&Environment{
+		Resources: &Resources{
+			Pipelines: map[string]*resources.Pipeline{
+				"pipeline": {
+					PipelineSpec: &pipelines.PipelineSpec{
+						Development: false,
+						Clusters: []pipelines.PipelineCluster{
+							{Label: "default", NumWorkers: 0},
+						},
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	// Zero values in the environment are indistinguishable from unset fields,
+	// so the values in the root are retained.
+	pipeline := root.Resources.Pipelines["pipeline"]
+	assert.True(t, pipeline.Development)
+	require.Len(t, pipeline.Clusters, 1)
+	assert.Equal(t, 2, pipeline.Clusters[0].NumWorkers)
+}
@@ -0,0 +1,125 @@
+package variable
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/compute"
+	"github.com/databricks/databricks-sdk-go/service/sql"
+)
+
+// Lookup resolves the name of a workspace object to its ID.
+// Exactly one of its fields must be set.
+type Lookup struct {
+	// Name of an all-purpose cluster.
+	Cluster string `json:"cluster,omitempty"`
+
+	// Name of a cluster policy.
+	ClusterPolicy string `json:"cluster_policy,omitempty"`
+
+	// Name of an instance pool.
+	InstancePool string `json:"instance_pool,omitempty"`
+
+	// Name of a SQL warehouse.
+	Warehouse string `json:"warehouse,omitempty"`
+}
+
+// namedObject is a workspace object with a name and an ID.
+type namedObject struct {
+	name string
+	id   string
+}
+
+// findByName returns the ID of the single object with the specified name.
+func findByName(kind, name string, objects []namedObject) (string, error) {
+	var ids []string
+	for _, o := range objects {
+		if o.name == name {
+			ids = append(ids, o.id)
+		}
+	}
+	switch len(ids) {
+	case 0:
+		return "", fmt.Errorf("%s named %q does not exist", kind, name)
+	case 1:
+		return ids[0], nil
+	default:
+		return "", fmt.Errorf("multiple %ss named %q exist (IDs: %s)", kind, name, strings.Join(ids, ", "))
+	}
+}
+
+func (l *Lookup) validate() error {
+	count := 0
+	for _, v := range []string{l.Cluster, l.ClusterPolicy, l.InstancePool, l.Warehouse} {
+		if v != "" {
+			count++
+		}
+	}
+	if count != 1 {
+		return fmt.Errorf("exactly one of cluster, cluster_policy, instance_pool, or warehouse must be specified in a lookup")
+	}
+	return nil
+}
+
+// Resolve returns the ID of the workspace object referenced by this lookup.
+func (l *Lookup) Resolve(ctx context.Context, w *databricks.WorkspaceClient) (string, error) {
+	err := l.validate()
+	if err != nil {
+		return "", err
+	}
+
+	var objects []namedObject
+	switch {
+	case l.Cluster != "":
+		clusters, err := w.Clusters.ListAll(ctx, compute.ListClustersRequest{})
+		if err != nil {
+			return "", err
+		}
+		for _, c := range clusters {
+			objects = append(objects, namedObject{c.ClusterName, c.ClusterId})
+		}
+		return findByName("cluster", l.Cluster, objects)
+	case l.ClusterPolicy != "":
+		policies, err := w.ClusterPolicies.ListAll(ctx, compute.ListClusterPoliciesRequest{})
+		if err != nil {
+			return "", err
+		}
+		for _, p := range policies {
+			objects = append(objects, namedObject{p.Name, p.PolicyId})
+		}
+		return findByName("cluster policy", l.ClusterPolicy, objects)
+	case l.InstancePool != "":
+		pools, err := w.InstancePools.ListAll(ctx)
+		if err != nil {
+			return "", err
+		}
+		for _, p := range pools {
+			objects = append(objects, namedObject{p.InstancePoolName, p.InstancePoolId})
+		}
+		return findByName("instance pool", l.InstancePool, objects)
+	default:
+		warehouses, err := w.Warehouses.ListAll(ctx, sql.ListWarehousesRequest{})
+		if err != nil {
+			return "", err
+		}
+		for _, wh := range warehouses {
+			objects = append(objects, namedObject{wh.Name, wh.Id})
+		}
+		return findByName("warehouse", l.Warehouse, objects)
+	}
+}
+
+func (l *Lookup) String() string {
+	switch {
+	case l.Cluster != "":
+		return fmt.Sprintf("cluster: %s", l.Cluster)
+	case l.ClusterPolicy != "":
+		return fmt.Sprintf("cluster_policy: %s", l.ClusterPolicy)
+	case l.InstancePool != "":
+		return fmt.Sprintf("instance_pool: %s", l.InstancePool)
+	default:
+		return fmt.Sprintf("warehouse: %s", l.Warehouse)
+	}
+}
@@ -0,0 +1,123 @@
+package variable
+
+import (
+	"context"
+	"testing"
+
+	"github.com/databricks/cli/internal/testutil"
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/compute"
+	"github.com/databricks/databricks-sdk-go/service/sql"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLookupFindByName(t *testing.T) {
+	objects := []namedObject{
+		{name: "foo", id: "1"},
+		{name: "bar", id: "2"},
+		{name: "bar", id: "3"},
+	}
+
+	id, err := findByName("cluster", "foo", objects)
+	assert.NoError(t, err)
+	assert.Equal(t, "1", id)
+
+	_, err = findByName("cluster", "baz", objects)
+	assert.ErrorContains(t, err, `cluster named "baz" does not exist`)
+
+	_, err = findByName("cluster", "bar", objects)
+	assert.ErrorContains(t, err, `multiple clusters named "bar" exist (IDs: 2, 3)`)
+}
+
+func TestLookupValidate(t *testing.T) {
+	assert.NoError(t, (&Lookup{Warehouse: "foo"}).validate())
+	assert.Error(t, (&Lookup{}).validate())
+	assert.Error(t, (&Lookup{Cluster: "foo", Warehouse: "bar"}).validate())
+}
+
+type mockClusters struct {
+	compute.ClustersService
+}
+
+func (mockClusters) List(context.Context, compute.ListClustersRequest) (*compute.ListClustersResponse, error) {
+	return &compute.ListClustersResponse{
+		Clusters: []compute.ClusterDetails{
+			{ClusterName: "shared", ClusterId: "cluster-1"},
+			{ClusterName: "other", ClusterId: "cluster-2"},
+		},
+	}, nil
+}
+
+type mockClusterPolicies struct {
+	compute.ClusterPoliciesService
+}
+
+func (mockClusterPolicies) List(context.Context, compute.ListClusterPoliciesRequest) (*compute.ListPoliciesResponse, error) {
+	return &compute.ListPoliciesResponse{
+		Policies: []compute.Policy{
+			{Name: "small", PolicyId: "policy-1"},
+		},
+	}, nil
+}
+
+type mockInstancePools struct {
+	compute.InstancePoolsService
+}
+
+func (mockInstancePools) List(context.Context) (*compute.ListInstancePools, error) {
+	return &compute.ListInstancePools{
+		InstancePools: []compute.InstancePoolAndStats{
+			{InstancePoolName: "pool", InstancePoolId: "pool-1"},
+			{InstancePoolName: "pool", InstancePoolId: "pool-2"},
+		},
+	}, nil
+}
+
+type mockWarehouses struct {
+	sql.WarehousesService
+}
+
+func (mockWarehouses) List(context.Context, sql.ListWarehousesRequest) (*sql.ListWarehousesResponse, error) {
+	return &sql.ListWarehousesResponse{
+		Warehouses: []sql.EndpointInfo{
+			{Name: "serverless", Id: "warehouse-1"},
+		},
+	}, nil
+}
+
+func mockWorkspaceClient() *databricks.WorkspaceClient {
+	return testutil.WorkspaceClient(testutil.WorkspaceServices{
+		Clusters:        mockClusters{},
+		ClusterPolicies: mockClusterPolicies{},
+		InstancePools:   mockInstancePools{},
+		Warehouses:      mockWarehouses{},
+	})
+}
+
+func TestLookupResolve(t *testing.T) {
+	ctx := context.Background()
+	w := mockWorkspaceClient()
+
+	for _, tc := range []struct {
+		lookup Lookup
+		id     string
+		err    string
+	}{
+		{lookup: Lookup{Cluster: "shared"}, id: "cluster-1"},
+		{lookup: Lookup{Cluster: "missing"}, err: `cluster named "missing" does not exist`},
+		{lookup: Lookup{ClusterPolicy: "small"}, id: "policy-1"},
+		{lookup: Lookup{InstancePool: "pool"}, err: `multiple instance pools named "pool" exist (IDs: pool-1, pool-2)`},
+		{lookup: Lookup{Warehouse: "serverless"}, id: "warehouse-1"},
+		{lookup: Lookup{Cluster: "shared", Warehouse: "serverless"}, err: "exactly one of"},
+	} {
+		t.Run(tc.lookup.String(), func(t *testing.T) {
+			id, err := tc.lookup.Resolve(ctx, w)
+			if tc.err != "" {
+				assert.ErrorContains(t, err, tc.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.id, id)
+		})
+	}
+}
@@ -1,19 +1,53 @@
 package variable
 
 import (
+	"encoding/json"
 	"fmt"
+	"strconv"
 )
 
 const VariableReferencePrefix = "var"
 
+// VariableType is the declared type of a variable.
+type VariableType string
+
+const (
+	// VariableTypeString is the default type of a variable.
+	// Values of this type are used verbatim.
+	VariableTypeString = VariableType("string")
+
+	// VariableTypeNumber, VariableTypeBool, VariableTypeList and VariableTypeMap
+	// are complex types. Values of these types are parsed as JSON when they are
+	// specified as a string (e.g. through the `--var` flag).
+	VariableTypeNumber = VariableType("number")
+	VariableTypeBool   = VariableType("bool")
+	VariableTypeList   = VariableType("list")
+	VariableTypeMap    = VariableType("map")
+)
+
 // An input variable for the bundle config
 type Variable struct {
+	// The type of this variable. Defaults to "string" if not specified.
+	Type VariableType `json:"type,omitempty"`
+
 	// A default value which then makes the variable optional
-	Default *string `json:"default,omitempty"`
+	Default any `json:"default,omitempty"`
 
 	// Documentation for this input variable
 	Description string `json:"description,omitempty"`
 
+	// If true, the value of this variable is masked when it is entered interactively.
+	Sensitive bool `json:"sensitive,omitempty"`
+
+	// Resolves the value of this variable from the name of a workspace object,
+	// for example `cluster: "shared-autoscaling"`. The lookup is used if no
+	// other value is assigned to the variable (see below).
+	//
+	// A variable cannot define both a default value and a lookup (see [Variable.Validate]).
+	// An environment can still assign a value to a variable with a lookup, in which
+	// case the lookup is not performed.
+	Lookup *Lookup `json:"lookup,omitempty"`
+
 	// This field stores the resolved value for the variable. The variable are
 	// resolved in the following priority order (from highest to lowest)
 	//
@@ -21,9 +55,11 @@ type Variable struct {
 	// 2. Environment variable. eg: BUNDLE_VAR_foo=bar
 	// 3. Default value as defined in the applicable environments block
 	// 4. Default value defined in variable definition
-	// 5. Throw error, since if no default value is defined, then the variable
+	// 5. Lookup of a workspace object defined in variable definition
+	// 6. Interactive prompt, if the CLI is running in a terminal
+	// 7. Throw error, since if no default value is defined, then the variable
 	//    is required
-	Value *string `json:"value,omitempty" bundle:"readonly"`
+	Value any `json:"value,omitempty" bundle:"readonly"`
 }
 
 // True if the variable has been assigned a default value. Variables without a
@@ -32,15 +68,116 @@ func (v *Variable) HasDefault() bool {
 	return v.Default != nil
 }
 
+// True if the variable resolves its value from a workspace object.
+func (v *Variable) HasLookup() bool {
+	return v.Lookup != nil
+}
+
+// Validate checks the definition of this variable. It returns an error if the
+// variable has both a default value and a lookup, because the lookup would never
+// be performed, or if the lookup is invalid.
+func (v *Variable) Validate() error {
+	if !v.HasLookup() {
+		return nil
+	}
+	if v.HasDefault() {
+		return fmt.Errorf("a variable cannot have both a default value and a lookup")
+	}
+	return v.Lookup.validate()
+}
+
 // True if variable has already been assigned a value
 func (v *Variable) HasValue() bool {
 	return v.Value != nil
 }
 
+// IsComplex returns true if the value of this variable is not a string.
+func (v *Variable) IsComplex() bool {
+	switch v.Type {
+	case "", VariableTypeString:
+		return false
+	default:
+		return true
+	}
+}
+
+// Set assigns a value specified as a string, for example through the `--var`
+// flag or a `BUNDLE_VAR_` environment variable. Values for complex types are parsed as JSON.
 func (v *Variable) Set(val string) error {
+	if !v.IsComplex() {
+		return v.SetValue(val)
+	}
+
+	var parsed any
+	err := json.Unmarshal([]byte(val), &parsed)
+	if err != nil {
+		return fmt.Errorf("unable to parse value as %s: %w", v.Type, err)
+	}
+	return v.SetValue(parsed)
+}
+
+// SetValue assigns a structured value, for example a default value from the configuration.
+func (v *Variable) SetValue(val any) error {
 	if v.HasValue() {
-		return fmt.Errorf("variable has already been assigned value: %s", *v.Value)
+		return fmt.Errorf("variable has already been assigned value: %s", ToString(v.Value))
 	}
-	v.Value = &val
+
+	val, err := v.normalize(val)
+	if err != nil {
+		return err
+	}
+
+	v.Value = val
 	return nil
 }
+
+// normalize checks that the specified value matches the type of this variable.
+// Scalar values assigned to a string variable are converted to a string.
+func (v *Variable) normalize(val any) (any, error) {
+	typ := v.Type
+	if typ == "" {
+		typ = VariableTypeString
+	}
+
+	var ok bool
+	switch typ {
+	case VariableTypeString:
+		switch val.(type) {
+		case string, float64, bool:
+			return ToString(val), nil
+		}
+	case VariableTypeNumber:
+		_, ok = val.(float64)
+	case VariableTypeBool:
+		_, ok = val.(bool)
+	case VariableTypeList:
+		_, ok = val.([]any)
+	case VariableTypeMap:
+		_, ok = val.(map[string]any)
+	default:
+		return nil, fmt.Errorf("unsupported variable type: %s", v.Type)
+	}
+	if !ok {
+		return nil, fmt.Errorf("expected a value of type %s but got %s", typ, ToString(val))
+	}
+	return val, nil
+}
+
+// ToString returns the string representation of a variable value.
+// This is used when a variable is referenced as part of a larger string.
+func ToString(val any) string {
+	switch v := val.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		buf, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(buf)
+	}
+}
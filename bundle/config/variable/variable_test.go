@@ -0,0 +1,67 @@
+package variable
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVariableSetString(t *testing.T) {
+	v := Variable{}
+	err := v.Set(`["a"]`)
+	require.NoError(t, err)
+	assert.Equal(t, `["a"]`, v.Value)
+}
+
+func TestVariableSetComplex(t *testing.T) {
+	v := Variable{Type: VariableTypeList}
+	err := v.Set(`["a", 1]`)
+	require.NoError(t, err)
+	assert.Equal(t, []any{"a", float64(1)}, v.Value)
+
+	v = Variable{Type: VariableTypeMap}
+	err = v.Set(`{"a": true}`)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"a": true}, v.Value)
+}
+
+func TestVariableSetComplexTypeMismatch(t *testing.T) {
+	v := Variable{Type: VariableTypeBool}
+	err := v.Set(`1`)
+	assert.ErrorContains(t, err, "expected a value of type bool but got 1")
+
+	v = Variable{Type: VariableTypeNumber}
+	err = v.Set(`one`)
+	assert.ErrorContains(t, err, "unable to parse value as number")
+}
+
+func TestVariableSetValueConvertsScalarsToString(t *testing.T) {
+	v := Variable{}
+	err := v.SetValue(float64(42))
+	require.NoError(t, err)
+	assert.Equal(t, "42", v.Value)
+
+	v = Variable{}
+	err = v.SetValue([]any{"a"})
+	assert.ErrorContains(t, err, `expected a value of type string but got ["a"]`)
+}
+
+func TestVariableSetValueTwice(t *testing.T) {
+	v := Variable{Type: VariableTypeNumber}
+	err := v.SetValue(float64(1))
+	require.NoError(t, err)
+	err = v.SetValue(float64(2))
+	assert.ErrorContains(t, err, "variable has already been assigned value: 1")
+}
+
+func TestVariableValidate(t *testing.T) {
+	assert.NoError(t, (&Variable{Default: "foo"}).Validate())
+	assert.NoError(t, (&Variable{Lookup: &Lookup{Cluster: "foo"}}).Validate())
+
+	err := (&Variable{Default: "foo", Lookup: &Lookup{Cluster: "foo"}}).Validate()
+	assert.ErrorContains(t, err, "a variable cannot have both a default value and a lookup")
+
+	err = (&Variable{Lookup: &Lookup{Cluster: "foo", Warehouse: "bar"}}).Validate()
+	assert.ErrorContains(t, err, "exactly one of cluster, cluster_policy, instance_pool, or warehouse must be specified in a lookup")
+}
@@ -0,0 +1,382 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Matches a string that consists of nothing but a reference to a variable.
+// The variable name pattern is identical to the one used for interpolation.
+var variableReferenceRegex = regexp.MustCompile(`^\$\{var\.([a-zA-Z]+([-_]?[a-zA-Z0-9]+)*)\}$`)
+
+// variableReference is a reference to a variable that makes up the entire value
+// of a field that cannot hold a string (e.g. `num_workers: ${var.workers}`).
+//
+// These references cannot be represented in the typed configuration tree.
+// They are removed from the tree when the configuration is loaded and the
+// variable values are assigned once they are known.
+// Also see [Root.ResolveVariableReferences].
+type variableReference struct {
+	// Path to the field in the configuration tree.
+	// Elements are either strings (struct fields and map keys) or ints (slice indices).
+	path []any
+
+	// Name of the referenced variable.
+	name string
+}
+
+func (ref variableReference) String() string {
+	var b strings.Builder
+	for _, elem := range ref.path {
+		switch v := elem.(type) {
+		case int:
+			fmt.Fprintf(&b, "[%d]", v)
+		case string:
+			if b.Len() > 0 {
+				b.WriteString(".")
+			}
+			b.WriteString(v)
+		}
+	}
+	return b.String()
+}
+
+// jsonFieldIndex returns the index sequence of the field with the specified
+// name in its `json` tag. It descends into embedded (anonymous) fields.
+func jsonFieldIndex(t reflect.Type, name string) ([]int, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if sf.Anonymous {
+			ft := sf.Type
+			if ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+			if ft.Kind() != reflect.Struct {
+				continue
+			}
+			if index, ok := jsonFieldIndex(ft, name); ok {
+				return append([]int{i}, index...), true
+			}
+			continue
+		}
+
+		tag := strings.Split(sf.Tag.Get("json"), ",")[0]
+		if tag != "" && tag != "-" && tag == name {
+			return []int{i}, true
+		}
+	}
+	return nil, false
+}
+
+// extractVariableReferences walks the YAML document `node` alongside the type `t`
+// it will be decoded into. It returns all references to variables in positions that
+// cannot hold a string and removes them from the document, such that it can be decoded.
+func extractVariableReferences(t reflect.Type, node *yaml.Node) []variableReference {
+	var out []variableReference
+
+	// Removals are deferred until the walk completes. Nodes can be shared through
+	// anchors and aliases, and every path that refers to them must be recorded.
+	var removals []func()
+
+	var walk func(t reflect.Type, node *yaml.Node, path []any, remove func())
+	var walkMapping func(t reflect.Type, node *yaml.Node, path []any, skip map[string]bool)
+
+	walk = func(t reflect.Type, node *yaml.Node, path []any, remove func()) {
+		for t.Kind() == reflect.Pointer {
+			t = t.Elem()
+		}
+
+		switch node.Kind {
+		case yaml.DocumentNode:
+			for _, n := range node.Content {
+				walk(t, n, path, nil)
+			}
+		case yaml.AliasNode:
+			walk(t, node.Alias, path, remove)
+		case yaml.ScalarNode:
+			m := variableReferenceRegex.FindStringSubmatch(node.Value)
+			if m == nil || remove == nil || t.Kind() == reflect.String || t.Kind() == reflect.Interface {
+				return
+			}
+			out = append(out, variableReference{
+				path: append([]any{}, path...),
+				name: m[1],
+			})
+			removals = append(removals, remove)
+		case yaml.MappingNode:
+			walkMapping(t, node, path, nil)
+		case yaml.SequenceNode:
+			if t.Kind() != reflect.Slice {
+				return
+			}
+			for i := range node.Content {
+				i := i
+				walk(t.Elem(), node.Content[i], append(path, i), func() {
+					node.Content[i] = &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "null"}
+				})
+			}
+		}
+	}
+
+	walkMapping = func(t reflect.Type, node *yaml.Node, path []any, skip map[string]bool) {
+		// Keys in the mapping itself take precedence over keys merged from an anchor.
+		keys := make(map[string]bool)
+		for k := range skip {
+			keys[k] = true
+		}
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			keys[node.Content[i].Value] = true
+		}
+
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+
+			if key.Value == "<<" && key.Tag == "!!merge" {
+				for value.Kind == yaml.AliasNode {
+					value = value.Alias
+				}
+				merged := []*yaml.Node{value}
+				if value.Kind == yaml.SequenceNode {
+					merged = value.Content
+				}
+				for _, m := range merged {
+					for m.Kind == yaml.AliasNode {
+						m = m.Alias
+					}
+					if m.Kind == yaml.MappingNode {
+						walkMapping(t, m, path, keys)
+					}
+				}
+				continue
+			}
+
+			if skip[key.Value] {
+				continue
+			}
+
+			var ct reflect.Type
+			switch t.Kind() {
+			case reflect.Struct:
+				index, ok := jsonFieldIndex(t, key.Value)
+				if !ok {
+					continue
+				}
+				ct = t.FieldByIndex(index).Type
+			case reflect.Map:
+				ct = t.Elem()
+			default:
+				continue
+			}
+
+			walk(ct, value, append(path, key.Value), func() {
+				for j := 0; j+1 < len(node.Content); j += 2 {
+					if node.Content[j] == key {
+						node.Content = append(node.Content[:j:j], node.Content[j+2:]...)
+						return
+					}
+				}
+			})
+		}
+	}
+
+	walk(t, node, nil, nil)
+	for _, remove := range removals {
+		remove()
+	}
+	return out
+}
+
+// lookupPath returns the value at the specified path.
+// It returns false if any of the values along the path don't exist.
+func lookupPath(rv reflect.Value, path []any) (reflect.Value, bool) {
+	for {
+		if rv.Kind() == reflect.Pointer || rv.Kind() == reflect.Interface {
+			if rv.IsNil() {
+				return reflect.Value{}, false
+			}
+			rv = rv.Elem()
+			continue
+		}
+		if len(path) == 0 {
+			return rv, true
+		}
+
+		switch rv.Kind() {
+		case reflect.Struct:
+			name, _ := path[0].(string)
+			index, ok := jsonFieldIndex(rv.Type(), name)
+			if !ok {
+				return reflect.Value{}, false
+			}
+			for _, i := range index {
+				if rv.Kind() == reflect.Pointer {
+					if rv.IsNil() {
+						return reflect.Value{}, false
+					}
+					rv = rv.Elem()
+				}
+				rv = rv.Field(i)
+			}
+		case reflect.Map:
+			name, _ := path[0].(string)
+			rv = rv.MapIndex(reflect.ValueOf(name).Convert(rv.Type().Key()))
+			if !rv.IsValid() {
+				return reflect.Value{}, false
+			}
+		case reflect.Slice:
+			i, ok := path[0].(int)
+			if !ok || i >= rv.Len() {
+				return reflect.Value{}, false
+			}
+			rv = rv.Index(i)
+		default:
+			return reflect.Value{}, false
+		}
+		path = path[1:]
+	}
+}
+
+// assignPath assigns the specified value to the settable value at the specified path.
+// Nil pointers and maps along the path are initialized.
+func assignPath(rv reflect.Value, path []any, value any) error {
+	if rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			rv.Set(reflect.New(rv.Type().Elem()))
+		}
+		return assignPath(rv.Elem(), path, value)
+	}
+
+	if len(path) == 0 {
+		buf, err := json.Marshal(value)
+		if err != nil {
+			return err
+		}
+		ptr := reflect.New(rv.Type())
+		err = json.Unmarshal(buf, ptr.Interface())
+		if err != nil {
+			return fmt.Errorf("cannot assign %s to a field of type %s", buf, rv.Type())
+		}
+		rv.Set(ptr.Elem())
+		return nil
+	}
+
+	switch rv.Kind() {
+	case reflect.Struct:
+		name, _ := path[0].(string)
+		index, ok := jsonFieldIndex(rv.Type(), name)
+		if !ok {
+			return fmt.Errorf("unknown field: %s", name)
+		}
+		for _, i := range index {
+			if rv.Kind() == reflect.Pointer {
+				if rv.IsNil() {
+					rv.Set(reflect.New(rv.Type().Elem()))
+				}
+				rv = rv.Elem()
+			}
+			rv = rv.Field(i)
+		}
+		return assignPath(rv, path[1:], value)
+	case reflect.Map:
+		name, _ := path[0].(string)
+		key := reflect.ValueOf(name).Convert(rv.Type().Key())
+		if rv.IsNil() {
+			rv.Set(reflect.MakeMap(rv.Type()))
+		}
+
+		// Map values are not addressable; update a copy and store it.
+		elem := reflect.New(rv.Type().Elem()).Elem()
+		if cur := rv.MapIndex(key); cur.IsValid() {
+			elem.Set(cur)
+		}
+		err := assignPath(elem, path[1:], value)
+		if err != nil {
+			return err
+		}
+		rv.SetMapIndex(key, elem)
+		return nil
+	case reflect.Slice:
+		i, _ := path[0].(int)
+		if i >= rv.Len() {
+			return fmt.Errorf("index out of range: %d", i)
+		}
+		return assignPath(rv.Index(i), path[1:], value)
+	default:
+		return fmt.Errorf("unable to descend into %s", rv.Type())
+	}
+}
+
+// isAssigned returns true if the value at the path of the reference is set.
+func (r *Root) isAssigned(ref variableReference) bool {
+	rv, ok := lookupPath(reflect.ValueOf(r).Elem(), ref.path)
+	return ok && !rv.IsZero()
+}
+
+// pruneVariableReferences drops references to variables for fields that have
+// been assigned a value by a merge. Explicit values take precedence over references.
+func (r *Root) pruneVariableReferences() {
+	var out []variableReference
+	for _, ref := range r.variableReferences {
+		if r.isAssigned(ref) {
+			continue
+		}
+		out = append(out, ref)
+	}
+	r.variableReferences = out
+}
+
+// rebasePath adjusts the slice indices of a path in an environment. Slices in
+// an environment are appended to the slices in the root configuration, so the
+// index in the first slice along the path is offset by the length of the
+// corresponding slice in the root configuration.
+func (r *Root) rebasePath(path []any) []any {
+	path = append([]any{}, path...)
+	for i, elem := range path {
+		index, ok := elem.(int)
+		if !ok {
+			continue
+		}
+		if rv, ok := lookupPath(reflect.ValueOf(r).Elem(), path[:i]); ok && rv.Kind() == reflect.Slice {
+			path[i] = index + rv.Len()
+		}
+		break
+	}
+	return path
+}
+
+// rebaseVariableReferences adjusts the slice indices of references defined in an environment.
+// Also see [Root.rebasePath].
+func (r *Root) rebaseVariableReferences(refs []variableReference) []variableReference {
+	var out []variableReference
+	for _, ref := range refs {
+		out = append(out, variableReference{path: r.rebasePath(ref.path), name: ref.name})
+	}
+	return out
+}
+
+// ResolveVariableReferences assigns variable values to the fields that
+// reference them in their entirety and cannot hold a string (e.g. numbers,
+// booleans, lists, and maps). It must be called after variable values are set.
+func (r *Root) ResolveVariableReferences() error {
+	for _, ref := range r.variableReferences {
+		v, ok := r.Variables[ref.name]
+		if !ok {
+			return r.ErrorAt(ref.String(), fmt.Errorf("%s: variable %s is not defined", ref, ref.name))
+		}
+		if !v.HasValue() {
+			return r.ErrorAt(ref.String(), fmt.Errorf("%s: variable %s has no value", ref, ref.name))
+		}
+		err := assignPath(reflect.ValueOf(r).Elem(), ref.path, v.Value)
+		if err != nil {
+			return r.ErrorAt(ref.String(), fmt.Errorf("%s: unable to assign value of variable %s: %w", ref, ref.name, err))
+		}
+	}
+
+	r.variableReferences = nil
+	return nil
+}
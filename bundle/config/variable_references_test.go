@@ -0,0 +1,58 @@
+package config
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/ghodss/yaml"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	yamlv3 "gopkg.in/yaml.v3"
+)
+
+func TestExtractVariableReferences(t *testing.T) {
+	var node yamlv3.Node
+	err := yamlv3.Unmarshal([]byte(`
+bundle:
+  name: ${var.name}
+cluster: &cluster
+  num_workers: ${var.workers}
+  autotermination_minutes: 10
+resources:
+  jobs:
+    foo:
+      max_concurrent_runs: ${var.runs}
+      tags: ${var.tags}
+      job_clusters:
+        - job_cluster_key: a
+          new_cluster: *cluster
+        - job_cluster_key: b
+          new_cluster:
+            <<: *cluster
+            num_workers: 2
+`), &node)
+	require.NoError(t, err)
+
+	refs := extractVariableReferences(reflect.TypeOf(&Root{}), &node)
+	var out []string
+	for _, ref := range refs {
+		out = append(out, ref.String()+"="+ref.name)
+	}
+
+	// References in string fields are retained for interpolation.
+	// Keys in a mapping take precedence over keys merged from an anchor.
+	assert.ElementsMatch(t, []string{
+		"resources.jobs.foo.max_concurrent_runs=runs",
+		"resources.jobs.foo.tags=tags",
+		"resources.jobs.foo.job_clusters[0].new_cluster.num_workers=workers",
+	}, out)
+
+	// The references are removed such that the document can be decoded.
+	buf, err := yamlv3.Marshal(&node)
+	require.NoError(t, err)
+	var r Root
+	err = yaml.Unmarshal(buf, &r)
+	require.NoError(t, err)
+	assert.Equal(t, "${var.name}", r.Bundle.Name)
+	assert.Equal(t, 2, r.Resources.Jobs["foo"].JobClusters[1].NewCluster.NumWorkers)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/artifacts/remote"
 	"github.com/databricks/cli/libs/cmdio"
 	"github.com/databricks/databricks-sdk-go/service/workspace"
 	"github.com/fatih/color"
@@ -22,6 +23,12 @@ func (m *delete) Apply(ctx context.Context, b *bundle.Bundle) error {
 		return nil
 	}
 
+	// Do not delete files if only some resources were destroyed
+	if len(b.TargetResources) > 0 {
+		cmdio.LogString(ctx, "Skipping deletion of remote bundle files since only selected resources were destroyed")
+		return nil
+	}
+
 	cmdio.LogString(ctx, "Starting deletion of remote bundle files")
 	cmdio.LogString(ctx, fmt.Sprintf("Bundle remote directory is %s", b.Config.Workspace.RootPath))
 
@@ -55,6 +62,13 @@ func (m *delete) Apply(ctx context.Context, b *bundle.Bundle) error {
 	}
 
 	cmdio.LogString(ctx, fmt.Sprintf("Deleted snapshot file at %s", sync.SnapshotPath()))
+
+	// Clean up the record of uploaded artifacts; they were deleted along with the root path.
+	err = remote.ClearUploadCache(b)
+	if err != nil {
+		return err
+	}
+
 	cmdio.LogString(ctx, "Successfully deleted files!")
 	return nil
 }
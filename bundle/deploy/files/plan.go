@@ -0,0 +1,53 @@
+package files
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/sync"
+)
+
+type plan struct{}
+
+func (m *plan) Name() string {
+	return "files.Plan"
+}
+
+func (m *plan) Apply(ctx context.Context, b *bundle.Bundle) error {
+	opts, err := getSyncOptions(b)
+	if err != nil {
+		return err
+	}
+
+	// Unlike [getSync], this doesn't create the remote files path.
+	changes, err := sync.Plan(ctx, *opts)
+	if err != nil {
+		return err
+	}
+
+	if changes.IsEmpty() {
+		cmdio.LogString(ctx, "No changes to bundle files")
+		return nil
+	}
+
+	sort.Strings(changes.Put)
+	sort.Strings(changes.Delete)
+
+	cmdio.LogString(ctx, fmt.Sprintf("The following bundle files will be changed at %s:", b.Config.Workspace.FilesPath))
+	for _, path := range changes.Put {
+		cmdio.LogString(ctx, fmt.Sprintf("upload %s", path))
+	}
+	for _, path := range changes.Delete {
+		cmdio.LogString(ctx, fmt.Sprintf("delete %s", path))
+	}
+	return nil
+}
+
+// Plan returns a [bundle.Mutator] that reports which bundle files
+// would be uploaded or deleted upon deployment without changing them.
+func Plan() bundle.Mutator {
+	return &plan{}
+}
@@ -8,19 +8,26 @@ import (
 	"github.com/databricks/cli/libs/sync"
 )
 
-func getSync(ctx context.Context, b *bundle.Bundle) (*sync.Sync, error) {
+func getSyncOptions(b *bundle.Bundle) (*sync.SyncOptions, error) {
 	cacheDir, err := b.CacheDir()
 	if err != nil {
 		return nil, fmt.Errorf("cannot get bundle cache directory: %w", err)
 	}
 
-	opts := sync.SyncOptions{
+	return &sync.SyncOptions{
 		LocalPath:  b.Config.Path,
 		RemotePath: b.Config.Workspace.FilesPath,
 		Full:       false,
 
 		SnapshotBasePath: cacheDir,
 		WorkspaceClient:  b.WorkspaceClient(),
+	}, nil
+}
+
+func getSync(ctx context.Context, b *bundle.Bundle) (*sync.Sync, error) {
+	opts, err := getSyncOptions(b)
+	if err != nil {
+		return nil, err
 	}
-	return sync.New(ctx, opts)
+	return sync.New(ctx, *opts)
 }
@@ -0,0 +1,78 @@
+package history
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/deploy/terraform"
+	"github.com/databricks/cli/internal/build"
+	"github.com/databricks/cli/libs/filer"
+	"github.com/databricks/cli/libs/log"
+)
+
+type appendRecord struct {
+	goal Goal
+}
+
+func (m *appendRecord) Name() string {
+	return "history.Append"
+}
+
+func (m *appendRecord) Apply(ctx context.Context, b *bundle.Bundle) error {
+	// Do not record anything if the changes were not consented to.
+	if b.Plan != nil && !b.Plan.IsEmpty && !b.Plan.ConfirmApply {
+		log.Infof(ctx, "Skipping deployment record; changes were not applied")
+		return nil
+	}
+
+	r := &Record{
+		Goal:        m.goal,
+		Timestamp:   time.Now().UTC(),
+		Git:         b.Config.Bundle.Git,
+		CliVersion:  build.GetInfo().Version,
+		Environment: b.Config.Bundle.Environment,
+	}
+
+	if m.goal == GoalDeploy {
+		for _, a := range b.Config.Artifacts {
+			if dir := a.RemoteDir(); dir != "" {
+				r.Artifacts = append(r.Artifacts, dir)
+			}
+		}
+		sort.Strings(r.Artifacts)
+	}
+
+	if b.Config.Workspace.CurrentUser != nil {
+		r.User = b.Config.Workspace.CurrentUser.UserName
+	}
+
+	if b.Plan != nil {
+		changes, err := terraform.PlanChanges(ctx, b)
+		if err != nil {
+			return err
+		}
+		r.Resources = changes
+	}
+
+	f, err := filer.NewWorkspaceFilesClient(b.WorkspaceClient(), b.Config.Workspace.StatePath)
+	if err != nil {
+		return err
+	}
+
+	h, err := Load(ctx, f)
+	if err != nil {
+		return err
+	}
+
+	log.Infof(ctx, "Writing deployment record to remote state directory")
+	h.Add(r)
+	return Save(ctx, f, h)
+}
+
+// Append returns a [bundle.Mutator] that adds a record of the deployment
+// or destruction to the deployment history in the remote state directory.
+func Append(goal Goal) bundle.Mutator {
+	return &appendRecord{goal}
+}
@@ -0,0 +1,105 @@
+package history
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/fs"
+	"time"
+
+	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/cli/bundle/deploy/terraform"
+	"github.com/databricks/cli/libs/filer"
+)
+
+// FileName is the name of the file in the state directory that holds the deployment history.
+const FileName = "deployments.json"
+
+// MaxRecords is the number of records that the deployment history is bounded to.
+const MaxRecords = 100
+
+type Goal string
+
+const (
+	GoalDeploy  = Goal("deploy")
+	GoalDestroy = Goal("destroy")
+)
+
+// Record describes a single deployment or destruction of a bundle.
+type Record struct {
+	Goal       Goal       `json:"goal"`
+	User       string     `json:"user,omitempty"`
+	Timestamp  time.Time  `json:"timestamp"`
+	Git        config.Git `json:"git,omitempty"`
+	CliVersion string     `json:"cli_version,omitempty"`
+
+	// Environment the bundle was deployed to.
+	Environment string `json:"environment,omitempty"`
+
+	// Resources that were created, updated, deleted or recreated.
+	Resources []*terraform.PlanResourceChange `json:"resources,omitempty"`
+
+	// Remote directories that hold the artifact files the deployment refers to.
+	Artifacts []string `json:"artifacts,omitempty"`
+}
+
+// History holds deployment records, most recent first.
+type History struct {
+	Records []*Record `json:"records"`
+}
+
+// Add prepends a record to the history and drops the oldest records
+// if the history holds more than [MaxRecords] records.
+func (h *History) Add(r *Record) {
+	h.Records = append([]*Record{r}, h.Records...)
+	if len(h.Records) > MaxRecords {
+		h.Records = h.Records[:MaxRecords]
+	}
+}
+
+// LastDeployment returns the most recent record if it is a deployment.
+// It returns nil if the history is empty or the bundle was destroyed since.
+func (h *History) LastDeployment() *Record {
+	if len(h.Records) == 0 || h.Records[0].Goal != GoalDeploy {
+		return nil
+	}
+	return h.Records[0]
+}
+
+// Load reads the deployment history from the specified filer.
+// It returns an empty history if none has been written yet.
+func Load(ctx context.Context, f filer.Filer) (*History, error) {
+	var h History
+
+	r, err := f.Read(ctx, FileName)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return &h, nil
+		}
+		return nil, err
+	}
+
+	buf, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(buf, &h)
+	if err != nil {
+		return nil, err
+	}
+
+	return &h, nil
+}
+
+// Save writes the deployment history to the specified filer.
+func Save(ctx context.Context, f filer.Filer, h *History) error {
+	buf, err := json.MarshalIndent(h, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return f.Write(ctx, FileName, bytes.NewReader(buf), filer.CreateParentDirectories, filer.OverwriteIfExists)
+}
@@ -0,0 +1,74 @@
+package history
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/cli/bundle/deploy/terraform"
+	"github.com/databricks/cli/libs/filer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHistoryAddIsBounded(t *testing.T) {
+	var h History
+	for i := 0; i < MaxRecords+10; i++ {
+		h.Add(&Record{Goal: GoalDeploy, User: fmt.Sprintf("user%d", i)})
+	}
+
+	require.Len(t, h.Records, MaxRecords)
+	assert.Equal(t, fmt.Sprintf("user%d", MaxRecords+9), h.Records[0].User)
+	assert.Equal(t, "user10", h.Records[MaxRecords-1].User)
+}
+
+func TestHistoryLoadAndSave(t *testing.T) {
+	ctx := context.Background()
+	f, err := filer.NewLocalClient(t.TempDir())
+	require.NoError(t, err)
+
+	// An empty history is returned if none has been written.
+	h, err := Load(ctx, f)
+	require.NoError(t, err)
+	assert.Empty(t, h.Records)
+
+	ts := time.Date(2023, 7, 1, 12, 0, 0, 0, time.UTC)
+	h.Add(&Record{
+		Goal:      GoalDeploy,
+		User:      "jane@doe.com",
+		Timestamp: ts,
+		Git: config.Git{
+			Branch:    "main",
+			Commit:    "abcdef",
+			OriginURL: "https://github.com/databricks/cli",
+		},
+		CliVersion: "0.200.0",
+		Resources: []*terraform.PlanResourceChange{
+			{ResourceType: "databricks_job", Action: "create", ResourceName: "foo"},
+		},
+	})
+	err = Save(ctx, f, h)
+	require.NoError(t, err)
+
+	h, err = Load(ctx, f)
+	require.NoError(t, err)
+	require.Len(t, h.Records, 1)
+	assert.Equal(t, "jane@doe.com", h.Records[0].User)
+	assert.Equal(t, ts, h.Records[0].Timestamp)
+	assert.Equal(t, "abcdef", h.Records[0].Git.Commit)
+	assert.Equal(t, "foo", h.Records[0].Resources[0].ResourceName)
+}
+
+func TestHistoryLastDeployment(t *testing.T) {
+	var h History
+	assert.Nil(t, h.LastDeployment())
+
+	h.Add(&Record{Goal: GoalDeploy, Artifacts: []string{"/Users/jane@doe.com/.bundle/artifacts/app/abc"}})
+	assert.Equal(t, []string{"/Users/jane@doe.com/.bundle/artifacts/app/abc"}, h.LastDeployment().Artifacts)
+
+	// Destroying the bundle deletes all deployed artifacts.
+	h.Add(&Record{Goal: GoalDestroy})
+	assert.Nil(t, h.LastDeployment())
+}
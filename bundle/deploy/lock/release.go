@@ -14,6 +14,8 @@ type Goal string
 const (
 	GoalDeploy  = Goal("deploy")
 	GoalDestroy = Goal("destroy")
+	GoalBind    = Goal("bind")
+	GoalUnbind  = Goal("unbind")
 )
 
 type release struct {
@@ -44,7 +46,7 @@ func (m *release) Apply(ctx context.Context, b *bundle.Bundle) error {
 
 	log.Infof(ctx, "Releasing deployment lock")
 	switch m.goal {
-	case GoalDeploy:
+	case GoalDeploy, GoalBind, GoalUnbind:
 		return b.Locker.Unlock(ctx)
 	case GoalDestroy:
 		return b.Locker.Unlock(ctx, locker.AllowLockFileNotExist)
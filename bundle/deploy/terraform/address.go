@@ -0,0 +1,69 @@
+package terraform
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/databricks/cli/bundle/config"
+	"golang.org/x/exp/maps"
+)
+
+type resourceKeysOfKind struct {
+	kind string
+	keys []string
+}
+
+// resourceTypeNames maps the Terraform resource type that resources of every kind
+// in the bundle configuration are deployed as, to the name used to display them.
+// Every resource type in [resourceKeys] must have an entry here.
+var resourceTypeNames = map[string]string{
+	"databricks_job":               "job",
+	"databricks_pipeline":          "pipeline",
+	"databricks_mlflow_model":      "model",
+	"databricks_mlflow_experiment": "experiment",
+	"databricks_model_serving":     "model serving endpoint",
+	"databricks_schema":            "schema",
+	"databricks_volume":            "volume",
+	"databricks_cluster":           "cluster",
+	"databricks_permissions":       "permissions",
+	"databricks_grants":            "grants",
+}
+
+// resourceKeys maps the Terraform resource type that resources of every kind
+// in the bundle configuration are deployed as, to their kind and keys.
+func resourceKeys(r *config.Resources) map[string]resourceKeysOfKind {
+	return map[string]resourceKeysOfKind{
+		"databricks_job":               {"jobs", maps.Keys(r.Jobs)},
+		"databricks_pipeline":          {"pipelines", maps.Keys(r.Pipelines)},
+		"databricks_mlflow_model":      {"models", maps.Keys(r.Models)},
+		"databricks_mlflow_experiment": {"experiments", maps.Keys(r.Experiments)},
+		"databricks_model_serving":     {"model_serving_endpoints", maps.Keys(r.ModelServingEndpoints)},
+		"databricks_schema":            {"schemas", maps.Keys(r.Schemas)},
+		"databricks_volume":            {"volumes", maps.Keys(r.Volumes)},
+		"databricks_cluster":           {"clusters", maps.Keys(r.Clusters)},
+	}
+}
+
+// ResourceAddress returns the Terraform address of the resource with the specified key.
+// The key can be qualified with the kind of resource (e.g. "jobs.foo") if it is ambiguous.
+func ResourceAddress(r *config.Resources, key string) (string, error) {
+	var matches []string
+	for resourceType, e := range resourceKeys(r) {
+		for _, k := range e.keys {
+			if k == key || e.kind+"."+k == key {
+				matches = append(matches, fmt.Sprintf("%s.%s", resourceType, k))
+			}
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("no such resource: %s", key)
+	case 1:
+		return matches[0], nil
+	default:
+		sort.Strings(matches)
+		return "", fmt.Errorf("ambiguous: %s (can resolve to all of %s)", key, strings.Join(matches, ", "))
+	}
+}
@@ -0,0 +1,66 @@
+package terraform
+
+import (
+	"testing"
+
+	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/cli/bundle/config/resources"
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceAddress(t *testing.T) {
+	r := &config.Resources{
+		Jobs: map[string]*resources.Job{
+			"foo": {},
+			"bar": {},
+		},
+		Pipelines: map[string]*resources.Pipeline{
+			"bar": {},
+		},
+		Clusters: map[string]*resources.Cluster{
+			"baz": {},
+		},
+	}
+
+	address, err := ResourceAddress(r, "foo")
+	require.NoError(t, err)
+	assert.Equal(t, "databricks_job.foo", address)
+
+	address, err = ResourceAddress(r, "pipelines.bar")
+	require.NoError(t, err)
+	assert.Equal(t, "databricks_pipeline.bar", address)
+
+	address, err = ResourceAddress(r, "baz")
+	require.NoError(t, err)
+	assert.Equal(t, "databricks_cluster.baz", address)
+
+	_, err = ResourceAddress(r, "bar")
+	assert.ErrorContains(t, err, "ambiguous: bar (can resolve to all of databricks_job.bar, databricks_pipeline.bar)")
+
+	_, err = ResourceAddress(r, "qux")
+	assert.ErrorContains(t, err, "no such resource: qux")
+}
+
+func TestStateContains(t *testing.T) {
+	state := &tfjson.State{
+		Values: &tfjson.StateValues{
+			RootModule: &tfjson.StateModule{
+				Resources: []*tfjson.StateResource{
+					{Address: "databricks_job.foo"},
+				},
+			},
+		},
+	}
+
+	assert.True(t, stateContains(state, "databricks_job.foo"))
+	assert.False(t, stateContains(state, "databricks_job.bar"))
+	assert.False(t, stateContains(&tfjson.State{}, "databricks_job.foo"))
+}
+
+func TestResourceTypeNames(t *testing.T) {
+	for resourceType := range resourceKeys(&config.Resources{}) {
+		assert.Contains(t, resourceTypeNames, resourceType)
+	}
+}
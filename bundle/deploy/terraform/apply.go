@@ -21,6 +21,22 @@ func (w *apply) Apply(ctx context.Context, b *bundle.Bundle) error {
 		return fmt.Errorf("terraform not initialized")
 	}
 
+	// Apply the plan computed by [Plan], if any.
+	var opts []tfexec.ApplyOption
+	if b.Plan != nil {
+		if b.Plan.IsEmpty {
+			cmdio.LogString(ctx, "No changes to resources. Skipping resource deployment!")
+			return nil
+		}
+
+		// Changes must have been approved by [Approve] before they are applied.
+		if !b.Plan.ConfirmApply {
+			return fmt.Errorf("resource deployment has not been approved")
+		}
+
+		opts = append(opts, tfexec.DirOrPlan(b.Plan.Path))
+	}
+
 	cmdio.LogString(ctx, "Starting resource deployment")
 
 	err := tf.Init(ctx, tfexec.Upgrade(true))
@@ -28,7 +44,7 @@ func (w *apply) Apply(ctx context.Context, b *bundle.Bundle) error {
 		return fmt.Errorf("terraform init: %w", err)
 	}
 
-	err = tf.Apply(ctx)
+	err = tf.Apply(ctx, opts...)
 	if err != nil {
 		return fmt.Errorf("terraform apply: %w", err)
 	}
@@ -39,6 +55,8 @@ func (w *apply) Apply(ctx context.Context, b *bundle.Bundle) error {
 
 // Apply returns a [bundle.Mutator] that runs the equivalent of `terraform apply`
 // from the bundle's ephemeral working directory for Terraform.
+// If a plan was computed by [Plan], it applies that plan once it has been approved
+// (see [Approve]).
 func Apply() bundle.Mutator {
 	return &apply{}
 }
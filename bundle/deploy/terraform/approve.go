@@ -0,0 +1,98 @@
+package terraform
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/flags"
+	"github.com/fatih/color"
+	"golang.org/x/term"
+)
+
+type approve struct{}
+
+func (w *approve) Name() string {
+	return "terraform.Approve"
+}
+
+// approve returns whether the changes in the plan are approved.
+// Changes that delete or recreate resources must be approved by the user,
+// either through the `--auto-approve` flag or interactively.
+func (w *approve) approve(ctx context.Context, b *bundle.Bundle) (bool, error) {
+	plan, err := b.Terraform.ShowPlanFile(ctx, b.Plan.Path)
+	if err != nil {
+		return false, err
+	}
+
+	tty := term.IsTerminal(int(os.Stderr.Fd()))
+	return approveChanges(ctx, planChanges(plan.ResourceChanges), b.Plan.ConfirmApply, tty)
+}
+
+// approveChanges returns whether the specified changes are approved.
+// The argument `autoApprove` approves all changes and `tty` indicates
+// whether the user can be prompted for approval.
+func approveChanges(ctx context.Context, changes []*PlanResourceChange, autoApprove, tty bool) (bool, error) {
+	if autoApprove {
+		return true, nil
+	}
+
+	var destructive []*PlanResourceChange
+	for _, c := range changes {
+		if c.Action == "delete" || c.Action == "recreate" {
+			destructive = append(destructive, c)
+		}
+	}
+	if len(destructive) == 0 {
+		return true, nil
+	}
+
+	cmdio.LogString(ctx, "The following resources will be deleted or recreated:")
+	for _, c := range destructive {
+		cmdio.Log(ctx, c)
+	}
+
+	// Interactive consent is not possible for json logging or non tty terminals.
+	logger, ok := cmdio.FromContext(ctx)
+	if ok && logger.Mode == flags.ModeJson {
+		return false, fmt.Errorf("please specify --auto-approve since selected logging format is json and this deployment deletes or recreates resources")
+	}
+	if !tty {
+		return false, fmt.Errorf("please specify --auto-approve to approve deleting or recreating resources for non tty consoles")
+	}
+
+	red := color.New(color.FgRed).SprintFunc()
+	return cmdio.Ask(ctx, fmt.Sprintf("\nThis will %s resources and their history! Proceed? [y/n]: ", red("delete or recreate")))
+}
+
+func (w *approve) Apply(ctx context.Context, b *bundle.Bundle) error {
+	if b.Plan == nil {
+		return fmt.Errorf("no plan to approve")
+	}
+	if b.Plan.IsEmpty {
+		return nil
+	}
+
+	ok, err := w.approve(ctx, b)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("resource deployment cancelled")
+	}
+
+	// Record consent for downstream mutators.
+	b.Plan.ConfirmApply = true
+	return nil
+}
+
+// Approve returns a [bundle.Mutator] that asks for approval of the plan computed by [Plan]
+// if it deletes or recreates resources. It returns an error if the plan is not approved.
+//
+// It runs before anything is uploaded to the workspace, such that declining the
+// plan leaves the previous deployment intact.
+func Approve() bundle.Mutator {
+	return &approve{}
+}
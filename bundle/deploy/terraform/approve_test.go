@@ -0,0 +1,64 @@
+package terraform
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/flags"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApproveChanges(t *testing.T) {
+	updates := []*PlanResourceChange{
+		{ResourceType: "databricks_job", Action: "create", ResourceName: "foo"},
+		{ResourceType: "databricks_job", Action: "update", ResourceName: "bar"},
+	}
+	deletes := []*PlanResourceChange{
+		{ResourceType: "databricks_job", Action: "update", ResourceName: "bar"},
+		{ResourceType: "databricks_pipeline", Action: "delete", ResourceName: "baz"},
+	}
+	recreates := []*PlanResourceChange{
+		{ResourceType: "databricks_pipeline", Action: "recreate", ResourceName: "baz"},
+	}
+
+	for _, tc := range []struct {
+		name        string
+		changes     []*PlanResourceChange
+		mode        flags.ProgressLogFormat
+		autoApprove bool
+		tty         bool
+		input       string
+		approved    bool
+		err         string
+	}{
+		{name: "no destructive changes", changes: updates, mode: flags.ModeAppend, approved: true},
+		{name: "no destructive changes in json mode", changes: updates, mode: flags.ModeJson, approved: true},
+		{name: "deletes with auto-approve", changes: deletes, mode: flags.ModeAppend, autoApprove: true, approved: true},
+		{name: "deletes with auto-approve in json mode", changes: deletes, mode: flags.ModeJson, autoApprove: true, approved: true},
+		{name: "deletes in json mode", changes: deletes, mode: flags.ModeJson, tty: true, err: "selected logging format is json"},
+		{name: "deletes without tty", changes: deletes, mode: flags.ModeAppend, err: "for non tty consoles"},
+		{name: "recreates without tty", changes: recreates, mode: flags.ModeAppend, err: "for non tty consoles"},
+		{name: "deletes approved by user", changes: deletes, mode: flags.ModeAppend, tty: true, input: "y\n", approved: true},
+		{name: "deletes declined by user", changes: deletes, mode: flags.ModeAppend, tty: true, input: "n\n", approved: false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := cmdio.NewContext(context.Background(), &cmdio.Logger{
+				Mode:   tc.mode,
+				Reader: *bufio.NewReader(strings.NewReader(tc.input)),
+				Writer: io.Discard,
+			})
+
+			approved, err := approveChanges(ctx, tc.changes, tc.autoApprove, tc.tty)
+			if tc.err != "" {
+				assert.ErrorContains(t, err, tc.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.approved, approved)
+		})
+	}
+}
@@ -44,6 +44,22 @@ func convPermission(ac resources.Permission) schema.ResourcePermissionsAccessCon
 	return dst
 }
 
+func convGrants(acl []resources.Grant) *schema.ResourceGrants {
+	if len(acl) == 0 {
+		return nil
+	}
+
+	resource := schema.ResourceGrants{}
+	for _, ac := range acl {
+		resource.Grant = append(resource.Grant, schema.ResourceGrantsGrant{
+			Privileges: ac.Privileges,
+			Principal:  ac.Principal,
+		})
+	}
+
+	return &resource
+}
+
 // BundleToTerraform converts resources in a bundle configuration
 // to the equivalent Terraform JSON representation.
 //
@@ -90,6 +106,14 @@ func BundleToTerraform(config *config.Root) *schema.Root {
 			}
 		}
 
+		// Jobs run as the identity configured for the bundle, unless they specify their own.
+		if config.RunAs != nil && dst.RunAs == nil {
+			dst.RunAs = &schema.ResourceJobRunAs{
+				UserName:             config.RunAs.UserName,
+				ServicePrincipalName: config.RunAs.ServicePrincipalName,
+			}
+		}
+
 		tfroot.Resource.Job[k] = &dst
 
 		// Configure permissions for this resource.
@@ -150,6 +174,54 @@ func BundleToTerraform(config *config.Root) *schema.Root {
 		}
 	}
 
+	for k, src := range config.Resources.ModelServingEndpoints {
+		var dst schema.ResourceModelServing
+		conv(src, &dst)
+		tfroot.Resource.ModelServing[k] = &dst
+
+		// Configure permissions for this resource.
+		if rp := convPermissions(src.Permissions); rp != nil {
+			rp.ServingEndpointId = fmt.Sprintf("${databricks_model_serving.%s.serving_endpoint_id}", k)
+			tfroot.Resource.Permissions["model_serving_"+k] = rp
+		}
+	}
+
+	for k, src := range config.Resources.Schemas {
+		var dst schema.ResourceSchema
+		conv(src, &dst)
+		tfroot.Resource.Schema[k] = &dst
+
+		// Configure grants for this resource.
+		if rg := convGrants(src.Grants); rg != nil {
+			rg.Schema = fmt.Sprintf("${databricks_schema.%s.id}", k)
+			tfroot.Resource.Grants["schema_"+k] = rg
+		}
+	}
+
+	for k, src := range config.Resources.Volumes {
+		var dst schema.ResourceVolume
+		conv(src, &dst)
+		tfroot.Resource.Volume[k] = &dst
+
+		// Configure grants for this resource.
+		if rg := convGrants(src.Grants); rg != nil {
+			rg.Volume = fmt.Sprintf("${databricks_volume.%s.id}", k)
+			tfroot.Resource.Grants["volume_"+k] = rg
+		}
+	}
+
+	for k, src := range config.Resources.Clusters {
+		var dst schema.ResourceCluster
+		conv(src, &dst)
+		tfroot.Resource.Cluster[k] = &dst
+
+		// Configure permissions for this resource.
+		if rp := convPermissions(src.Permissions); rp != nil {
+			rp.ClusterId = fmt.Sprintf("${databricks_cluster.%s.cluster_id}", k)
+			tfroot.Resource.Permissions["cluster_"+k] = rp
+		}
+	}
+
 	return tfroot
 }
 
@@ -185,7 +257,31 @@ func TerraformToBundle(state *tfjson.State, config *config.Root) error {
 			cur := config.Resources.Experiments[resource.Name]
 			conv(tmp, &cur)
 			config.Resources.Experiments[resource.Name] = cur
-		case "databricks_permissions":
+		case "databricks_model_serving":
+			var tmp schema.ResourceModelServing
+			conv(resource.AttributeValues, &tmp)
+			cur := config.Resources.ModelServingEndpoints[resource.Name]
+			conv(tmp, &cur)
+			config.Resources.ModelServingEndpoints[resource.Name] = cur
+		case "databricks_schema":
+			var tmp schema.ResourceSchema
+			conv(resource.AttributeValues, &tmp)
+			cur := config.Resources.Schemas[resource.Name]
+			conv(tmp, &cur)
+			config.Resources.Schemas[resource.Name] = cur
+		case "databricks_volume":
+			var tmp schema.ResourceVolume
+			conv(resource.AttributeValues, &tmp)
+			cur := config.Resources.Volumes[resource.Name]
+			conv(tmp, &cur)
+			config.Resources.Volumes[resource.Name] = cur
+		case "databricks_cluster":
+			var tmp schema.ResourceCluster
+			conv(resource.AttributeValues, &tmp)
+			cur := config.Resources.Clusters[resource.Name]
+			conv(tmp, &cur)
+			config.Resources.Clusters[resource.Name] = cur
+		case "databricks_permissions", "databricks_grants":
 			// Ignore; no need to pull these back into the configuration.
 		default:
 			return fmt.Errorf("missing mapping for %s", resource.Type)
@@ -5,10 +5,12 @@ import (
 
 	"github.com/databricks/cli/bundle/config"
 	"github.com/databricks/cli/bundle/config/resources"
+	"github.com/databricks/databricks-sdk-go/service/catalog"
 	"github.com/databricks/databricks-sdk-go/service/compute"
 	"github.com/databricks/databricks-sdk-go/service/jobs"
 	"github.com/databricks/databricks-sdk-go/service/ml"
 	"github.com/databricks/databricks-sdk-go/service/pipelines"
+	"github.com/databricks/databricks-sdk-go/service/serving"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -108,6 +110,37 @@ func TestConvertJobTaskLibraries(t *testing.T) {
 	assert.Equal(t, "mlflow", out.Resource.Job["my_job"].Task[0].Library[0].Pypi.Package)
 }
 
+func TestConvertJobRunAs(t *testing.T) {
+	var config = config.Root{
+		RunAs: &jobs.JobRunAs{
+			ServicePrincipalName: "my-service-principal",
+		},
+		Resources: config.Resources{
+			Jobs: map[string]*resources.Job{
+				"job_a": {
+					JobSettings: &jobs.JobSettings{
+						Name: "job a",
+					},
+				},
+				"job_b": {
+					JobSettings: &jobs.JobSettings{
+						Name: "job b",
+						RunAs: &jobs.JobRunAs{
+							UserName: "jane@doe.com",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	out := BundleToTerraform(&config)
+	assert.Equal(t, "my-service-principal", out.Resource.Job["job_a"].RunAs.ServicePrincipalName)
+	assert.Equal(t, "", out.Resource.Job["job_a"].RunAs.UserName)
+	assert.Equal(t, "jane@doe.com", out.Resource.Job["job_b"].RunAs.UserName)
+	assert.Equal(t, "", out.Resource.Job["job_b"].RunAs.ServicePrincipalName)
+}
+
 func TestConvertPipeline(t *testing.T) {
 	var src = resources.Pipeline{
 		PipelineSpec: &pipelines.PipelineSpec{
@@ -279,3 +312,245 @@ func TestConvertExperimentPermissions(t *testing.T) {
 	assert.Equal(t, "CAN_READ", p.PermissionLevel)
 
 }
+
+func TestConvertModelServing(t *testing.T) {
+	var src = resources.ModelServingEndpoint{
+		CreateServingEndpoint: &serving.CreateServingEndpoint{
+			Name: "name",
+			Config: serving.EndpointCoreConfigInput{
+				ServedModels: []serving.ServedModelInput{
+					{
+						ModelName:          "model_name",
+						ModelVersion:       "1",
+						ScaleToZeroEnabled: true,
+						WorkloadSize:       "Small",
+					},
+				},
+				TrafficConfig: &serving.TrafficConfig{
+					Routes: []serving.Route{
+						{
+							ServedModelName:   "model_name-1",
+							TrafficPercentage: 100,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	var config = config.Root{
+		Resources: config.Resources{
+			ModelServingEndpoints: map[string]*resources.ModelServingEndpoint{
+				"my_model_serving_endpoint": &src,
+			},
+		},
+	}
+
+	out := BundleToTerraform(&config)
+	resource := out.Resource.ModelServing["my_model_serving_endpoint"]
+	assert.Equal(t, "name", resource.Name)
+	require.Len(t, resource.Config.ServedModels, 1)
+	assert.Equal(t, "model_name", resource.Config.ServedModels[0].ModelName)
+	assert.Equal(t, "1", resource.Config.ServedModels[0].ModelVersion)
+	assert.True(t, resource.Config.ServedModels[0].ScaleToZeroEnabled)
+	assert.Equal(t, "Small", resource.Config.ServedModels[0].WorkloadSize)
+	require.Len(t, resource.Config.TrafficConfig.Routes, 1)
+	assert.Equal(t, "model_name-1", resource.Config.TrafficConfig.Routes[0].ServedModelName)
+	assert.Equal(t, 100, resource.Config.TrafficConfig.Routes[0].TrafficPercentage)
+	assert.Nil(t, out.Data)
+}
+
+func TestConvertModelServingPermissions(t *testing.T) {
+	var src = resources.ModelServingEndpoint{
+		Permissions: []resources.Permission{
+			{
+				Level:    "CAN_VIEW",
+				UserName: "jane@doe.com",
+			},
+		},
+	}
+
+	var config = config.Root{
+		Resources: config.Resources{
+			ModelServingEndpoints: map[string]*resources.ModelServingEndpoint{
+				"my_model_serving_endpoint": &src,
+			},
+		},
+	}
+
+	out := BundleToTerraform(&config)
+	assert.NotEmpty(t, out.Resource.Permissions["model_serving_my_model_serving_endpoint"].ServingEndpointId)
+	assert.Len(t, out.Resource.Permissions["model_serving_my_model_serving_endpoint"].AccessControl, 1)
+
+	p := out.Resource.Permissions["model_serving_my_model_serving_endpoint"].AccessControl[0]
+	assert.Equal(t, "jane@doe.com", p.UserName)
+	assert.Equal(t, "CAN_VIEW", p.PermissionLevel)
+}
+
+func TestConvertSchema(t *testing.T) {
+	var src = resources.Schema{
+		CreateSchema: &catalog.CreateSchema{
+			Name:        "name",
+			CatalogName: "catalog",
+			Comment:     "comment",
+			Properties: map[string]string{
+				"k1": "v1",
+			},
+		},
+	}
+
+	var config = config.Root{
+		Resources: config.Resources{
+			Schemas: map[string]*resources.Schema{
+				"my_schema": &src,
+			},
+		},
+	}
+
+	out := BundleToTerraform(&config)
+	assert.Equal(t, "name", out.Resource.Schema["my_schema"].Name)
+	assert.Equal(t, "catalog", out.Resource.Schema["my_schema"].CatalogName)
+	assert.Equal(t, "comment", out.Resource.Schema["my_schema"].Comment)
+	assert.Equal(t, "v1", out.Resource.Schema["my_schema"].Properties["k1"])
+	assert.Nil(t, out.Data)
+}
+
+func TestConvertSchemaGrants(t *testing.T) {
+	var src = resources.Schema{
+		Grants: []resources.Grant{
+			{
+				Privileges: []string{"USE_SCHEMA", "READ_VOLUME"},
+				Principal:  "jane@doe.com",
+			},
+		},
+	}
+
+	var config = config.Root{
+		Resources: config.Resources{
+			Schemas: map[string]*resources.Schema{
+				"my_schema": &src,
+			},
+		},
+	}
+
+	out := BundleToTerraform(&config)
+	assert.Equal(t, "${databricks_schema.my_schema.id}", out.Resource.Grants["schema_my_schema"].Schema)
+	assert.Len(t, out.Resource.Grants["schema_my_schema"].Grant, 1)
+
+	g := out.Resource.Grants["schema_my_schema"].Grant[0]
+	assert.Equal(t, "jane@doe.com", g.Principal)
+	assert.Equal(t, []string{"USE_SCHEMA", "READ_VOLUME"}, g.Privileges)
+	assert.Empty(t, out.Resource.Permissions)
+}
+
+func TestConvertVolume(t *testing.T) {
+	var src = resources.Volume{
+		CreateVolumeRequestContent: &catalog.CreateVolumeRequestContent{
+			Name:        "name",
+			CatalogName: "catalog",
+			SchemaName:  "${databricks_schema.my_schema.name}",
+			VolumeType:  catalog.VolumeTypeManaged,
+		},
+	}
+
+	var config = config.Root{
+		Resources: config.Resources{
+			Volumes: map[string]*resources.Volume{
+				"my_volume": &src,
+			},
+		},
+	}
+
+	out := BundleToTerraform(&config)
+	assert.Equal(t, "name", out.Resource.Volume["my_volume"].Name)
+	assert.Equal(t, "catalog", out.Resource.Volume["my_volume"].CatalogName)
+	assert.Equal(t, "${databricks_schema.my_schema.name}", out.Resource.Volume["my_volume"].SchemaName)
+	assert.Equal(t, "MANAGED", out.Resource.Volume["my_volume"].VolumeType)
+	assert.Nil(t, out.Data)
+}
+
+func TestConvertVolumeGrants(t *testing.T) {
+	var src = resources.Volume{
+		Grants: []resources.Grant{
+			{
+				Privileges: []string{"READ_VOLUME", "WRITE_VOLUME"},
+				Principal:  "jane@doe.com",
+			},
+		},
+	}
+
+	var config = config.Root{
+		Resources: config.Resources{
+			Volumes: map[string]*resources.Volume{
+				"my_volume": &src,
+			},
+		},
+	}
+
+	out := BundleToTerraform(&config)
+	assert.Equal(t, "${databricks_volume.my_volume.id}", out.Resource.Grants["volume_my_volume"].Volume)
+	assert.Len(t, out.Resource.Grants["volume_my_volume"].Grant, 1)
+
+	g := out.Resource.Grants["volume_my_volume"].Grant[0]
+	assert.Equal(t, "jane@doe.com", g.Principal)
+	assert.Equal(t, []string{"READ_VOLUME", "WRITE_VOLUME"}, g.Privileges)
+	assert.Empty(t, out.Resource.Permissions)
+}
+
+func TestConvertCluster(t *testing.T) {
+	var src = resources.Cluster{
+		ClusterSpec: &compute.ClusterSpec{
+			ClusterName:  "shared",
+			SparkVersion: "13.2.x-scala2.12",
+			NodeTypeId:   "i3.xlarge",
+			Autoscale: &compute.AutoScale{
+				MinWorkers: 1,
+				MaxWorkers: 4,
+			},
+			AutoterminationMinutes: 60,
+		},
+	}
+
+	var config = config.Root{
+		Resources: config.Resources{
+			Clusters: map[string]*resources.Cluster{
+				"my_cluster": &src,
+			},
+		},
+	}
+
+	out := BundleToTerraform(&config)
+	assert.Equal(t, "shared", out.Resource.Cluster["my_cluster"].ClusterName)
+	assert.Equal(t, "13.2.x-scala2.12", out.Resource.Cluster["my_cluster"].SparkVersion)
+	assert.Equal(t, "i3.xlarge", out.Resource.Cluster["my_cluster"].NodeTypeId)
+	assert.Equal(t, 4, out.Resource.Cluster["my_cluster"].Autoscale.MaxWorkers)
+	assert.Equal(t, 60, out.Resource.Cluster["my_cluster"].AutoterminationMinutes)
+	assert.Nil(t, out.Data)
+}
+
+func TestConvertClusterPermissions(t *testing.T) {
+	var src = resources.Cluster{
+		Permissions: []resources.Permission{
+			{
+				Level:     "CAN_RESTART",
+				GroupName: "data-scientists",
+			},
+		},
+	}
+
+	var config = config.Root{
+		Resources: config.Resources{
+			Clusters: map[string]*resources.Cluster{
+				"my_cluster": &src,
+			},
+		},
+	}
+
+	out := BundleToTerraform(&config)
+	assert.Equal(t, "${databricks_cluster.my_cluster.cluster_id}", out.Resource.Permissions["cluster_my_cluster"].ClusterId)
+	assert.Len(t, out.Resource.Permissions["cluster_my_cluster"].AccessControl, 1)
+
+	p := out.Resource.Permissions["cluster_my_cluster"].AccessControl[0]
+	assert.Equal(t, "data-scientists", p.GroupName)
+	assert.Equal(t, "CAN_RESTART", p.PermissionLevel)
+}
@@ -26,14 +26,11 @@ func (c *PlanResourceChange) String() string {
 	default:
 		result.WriteString(c.Action + " ")
 	}
-	switch c.ResourceType {
-	case "databricks_job":
-		result.WriteString("job ")
-	case "databricks_pipeline":
-		result.WriteString("pipeline ")
-	default:
-		result.WriteString(c.ResourceType + " ")
+	name, ok := resourceTypeNames[c.ResourceType]
+	if !ok {
+		name = c.ResourceType
 	}
+	result.WriteString(name + " ")
 	result.WriteString(c.ResourceName)
 	return result.String()
 }
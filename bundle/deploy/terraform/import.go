@@ -0,0 +1,133 @@
+package terraform
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/hashicorp/terraform-exec/tfexec"
+	tfjson "github.com/hashicorp/terraform-json"
+)
+
+// stateContains returns true if the Terraform state contains the resource at the specified address.
+func stateContains(state *tfjson.State, address string) bool {
+	if state == nil || state.Values == nil || state.Values.RootModule == nil {
+		return false
+	}
+	for _, resource := range state.Values.RootModule.Resources {
+		if resource.Address == address {
+			return true
+		}
+	}
+	return false
+}
+
+type importResource struct {
+	key string
+	id  string
+}
+
+func (m *importResource) Name() string {
+	return "terraform.Import"
+}
+
+func (m *importResource) Apply(ctx context.Context, b *bundle.Bundle) error {
+	tf := b.Terraform
+	if tf == nil {
+		return fmt.Errorf("terraform not initialized")
+	}
+
+	address, err := ResourceAddress(&b.Config.Resources, m.key)
+	if err != nil {
+		return err
+	}
+
+	err = tf.Init(ctx, tfexec.Upgrade(true))
+	if err != nil {
+		return fmt.Errorf("terraform init: %w", err)
+	}
+
+	state, err := tf.Show(ctx)
+	if err != nil {
+		return err
+	}
+	if stateContains(state, address) {
+		return fmt.Errorf("resource %s is already bound; unbind it first", m.key)
+	}
+
+	// Ask for confirmation, if needed
+	if !b.AutoApprove {
+		ok, err := cmdio.Ask(ctx, fmt.Sprintf("Binding %s to %s means that the next deployment overwrites its remote configuration. Proceed? [y/n]: ", m.key, m.id))
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return fmt.Errorf("binding %s was not confirmed", m.key)
+		}
+	}
+
+	err = tf.Import(ctx, address, m.id)
+	if err != nil {
+		return fmt.Errorf("terraform import: %w", err)
+	}
+
+	cmdio.LogString(ctx, fmt.Sprintf("Successfully bound %s to %s", m.key, m.id))
+	return nil
+}
+
+// Import returns a [bundle.Mutator] that runs the equivalent of `terraform import`
+// to record that the resource with the specified key is deployed as the remote
+// resource with the specified ID.
+func Import(key, id string) bundle.Mutator {
+	return &importResource{key: key, id: id}
+}
+
+type stateRm struct {
+	key string
+}
+
+func (m *stateRm) Name() string {
+	return "terraform.StateRm"
+}
+
+func (m *stateRm) Apply(ctx context.Context, b *bundle.Bundle) error {
+	tf := b.Terraform
+	if tf == nil {
+		return fmt.Errorf("terraform not initialized")
+	}
+
+	address, err := ResourceAddress(&b.Config.Resources, m.key)
+	if err != nil {
+		return err
+	}
+
+	err = tf.Init(ctx, tfexec.Upgrade(true))
+	if err != nil {
+		return fmt.Errorf("terraform init: %w", err)
+	}
+
+	state, err := tf.Show(ctx)
+	if err != nil {
+		return err
+	}
+	if !stateContains(state, address) {
+		cmdio.LogString(ctx, fmt.Sprintf("Resource %s is not bound; nothing to do", m.key))
+		return nil
+	}
+
+	err = tf.StateRm(ctx, address)
+	if err != nil {
+		return fmt.Errorf("terraform state rm: %w", err)
+	}
+
+	cmdio.LogString(ctx, fmt.Sprintf("Successfully unbound %s", m.key))
+	return nil
+}
+
+// StateRm returns a [bundle.Mutator] that runs the equivalent of `terraform state rm`
+// to remove the resource with the specified key from the deployment state.
+// The remote resource is not deleted.
+func StateRm(key string) bundle.Mutator {
+	return &stateRm{key: key}
+}
@@ -25,6 +25,18 @@ func interpolateTerraformResourceIdentifiers(path string, lookup map[string]stri
 		case "experiments":
 			path = strings.Join(append([]string{"databricks_mlflow_experiment"}, parts[2:]...), interpolation.Delimiter)
 			return fmt.Sprintf("${%s}", path), nil
+		case "model_serving_endpoints":
+			path = strings.Join(append([]string{"databricks_model_serving"}, parts[2:]...), interpolation.Delimiter)
+			return fmt.Sprintf("${%s}", path), nil
+		case "schemas":
+			path = strings.Join(append([]string{"databricks_schema"}, parts[2:]...), interpolation.Delimiter)
+			return fmt.Sprintf("${%s}", path), nil
+		case "volumes":
+			path = strings.Join(append([]string{"databricks_volume"}, parts[2:]...), interpolation.Delimiter)
+			return fmt.Sprintf("${%s}", path), nil
+		case "clusters":
+			path = strings.Join(append([]string{"databricks_cluster"}, parts[2:]...), interpolation.Delimiter)
+			return fmt.Sprintf("${%s}", path), nil
 		default:
 			panic("TODO: " + parts[1])
 		}
@@ -33,6 +45,31 @@ func interpolateTerraformResourceIdentifiers(path string, lookup map[string]stri
 	return interpolation.DefaultLookup(path, lookup)
 }
 
+// Remote paths of artifacts are only known once the artifacts have been built.
+func interpolateBuiltArtifacts(path string, lookup map[string]string) (string, error) {
+	parts := strings.Split(path, interpolation.Delimiter)
+	if parts[0] != "artifacts" {
+		return "", interpolation.ErrSkipInterpolation
+	}
+	v, err := interpolation.DefaultLookup(path, lookup)
+	if err == nil && v == "" {
+		return "", fmt.Errorf("artifact %s has not been built; cannot resolve ${%s}", parts[1], path)
+	}
+	return v, err
+}
+
+// Interpolate rewrites references to resources into Terraform compatible format.
+//
+// Artifacts don't need to be built for this. References to their remote paths
+// resolve to an empty string, which is fine for commands that only need the
+// identifiers of deployed resources (e.g. run, summary, bind).
 func Interpolate() bundle.Mutator {
 	return interpolation.Interpolate(interpolateTerraformResourceIdentifiers)
 }
+
+// InterpolateForDeploy is like [Interpolate] but returns an error for references to
+// artifacts that have not been built, because their remote paths are deployed as part
+// of the Terraform configuration.
+func InterpolateForDeploy() bundle.Mutator {
+	return interpolation.Interpolate(interpolateBuiltArtifacts, interpolateTerraformResourceIdentifiers)
+}
@@ -0,0 +1,156 @@
+package terraform
+
+import (
+	"context"
+	"testing"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/cli/bundle/config/resources"
+	"github.com/databricks/databricks-sdk-go/service/catalog"
+	"github.com/databricks/databricks-sdk-go/service/compute"
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+	"github.com/databricks/databricks-sdk-go/service/pipelines"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInterpolateUnityCatalogReferences(t *testing.T) {
+	b := &bundle.Bundle{
+		Config: config.Root{
+			Resources: config.Resources{
+				Schemas: map[string]*resources.Schema{
+					"my_schema": {
+						CreateSchema: &catalog.CreateSchema{
+							Name:        "my_schema",
+							CatalogName: "main",
+						},
+					},
+				},
+				Volumes: map[string]*resources.Volume{
+					"my_volume": {
+						CreateVolumeRequestContent: &catalog.CreateVolumeRequestContent{
+							Name:        "my_volume",
+							CatalogName: "main",
+							SchemaName:  "${resources.schemas.my_schema.name}",
+							VolumeType:  catalog.VolumeTypeManaged,
+						},
+					},
+				},
+				Pipelines: map[string]*resources.Pipeline{
+					"my_pipeline": {
+						PipelineSpec: &pipelines.PipelineSpec{
+							Target: "${resources.schemas.my_schema.name}",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	err := bundle.Apply(context.Background(), b, Interpolate())
+	require.NoError(t, err)
+	assert.Equal(t, "${databricks_schema.my_schema.name}", b.Config.Resources.Volumes["my_volume"].SchemaName)
+	assert.Equal(t, "${databricks_schema.my_schema.name}", b.Config.Resources.Pipelines["my_pipeline"].Target)
+}
+
+func TestInterpolateClusterReferenceInJobTask(t *testing.T) {
+	b := &bundle.Bundle{
+		Config: config.Root{
+			Resources: config.Resources{
+				Clusters: map[string]*resources.Cluster{
+					"shared": {
+						ClusterSpec: &compute.ClusterSpec{
+							ClusterName:  "shared",
+							SparkVersion: "13.2.x-scala2.12",
+						},
+					},
+				},
+				Jobs: map[string]*resources.Job{
+					"my_job": {
+						JobSettings: &jobs.JobSettings{
+							Tasks: []jobs.Task{
+								{
+									TaskKey:           "main",
+									ExistingClusterId: "${resources.clusters.shared.id}",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	err := bundle.Apply(context.Background(), b, Interpolate())
+	require.NoError(t, err)
+	assert.Equal(t, "${databricks_cluster.shared.id}", b.Config.Resources.Jobs["my_job"].Tasks[0].ExistingClusterId)
+}
+
+func TestInterpolateUnbuiltArtifactReference(t *testing.T) {
+	b := &bundle.Bundle{
+		Config: config.Root{
+			Artifacts: map[string]*config.Artifact{
+				"my_wheel": {
+					PythonWheel: &config.PythonWheelArtifact{Path: "."},
+				},
+			},
+			Resources: config.Resources{
+				Jobs: map[string]*resources.Job{
+					"my_job": {
+						JobSettings: &jobs.JobSettings{
+							Tasks: []jobs.Task{
+								{
+									Libraries: []compute.Library{
+										{Whl: "${artifacts.my_wheel.remote_path}"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	err := bundle.Apply(context.Background(), b, InterpolateForDeploy())
+	assert.ErrorContains(t, err, "artifact my_wheel has not been built; cannot resolve ${artifacts.my_wheel.remote_path}")
+
+	// The reference resolves once the artifact has been built.
+	b.Config.Artifacts["my_wheel"].RemotePath = "/Workspace/foo/my_wheel.whl"
+	err = bundle.Apply(context.Background(), b, InterpolateForDeploy())
+	require.NoError(t, err)
+	assert.Equal(t, "/Workspace/foo/my_wheel.whl", b.Config.Resources.Jobs["my_job"].Tasks[0].Libraries[0].Whl)
+}
+
+func TestInterpolateUnbuiltArtifactReferenceOutsideDeploy(t *testing.T) {
+	b := &bundle.Bundle{
+		Config: config.Root{
+			Artifacts: map[string]*config.Artifact{
+				"my_wheel": {
+					PythonWheel: &config.PythonWheelArtifact{Path: "."},
+				},
+			},
+			Resources: config.Resources{
+				Jobs: map[string]*resources.Job{
+					"my_job": {
+						JobSettings: &jobs.JobSettings{
+							Tasks: []jobs.Task{
+								{
+									Libraries: []compute.Library{
+										{Whl: "${artifacts.my_wheel.remote_path}"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	// Commands that don't deploy don't need artifacts to be built.
+	err := bundle.Apply(context.Background(), b, Interpolate())
+	require.NoError(t, err)
+	assert.Equal(t, "", b.Config.Resources.Jobs["my_job"].Tasks[0].Libraries[0].Whl)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"path/filepath"
+	"strings"
 
 	"github.com/databricks/cli/bundle"
 	"github.com/databricks/cli/libs/cmdio"
@@ -26,6 +27,20 @@ func (p *plan) Name() string {
 	return "terraform.Plan"
 }
 
+// targets returns the Terraform addresses of the resources selected in the bundle.
+func (p *plan) targets(b *bundle.Bundle) ([]string, error) {
+	var selected []string
+	for _, key := range b.TargetResources {
+		address, err := ResourceAddress(&b.Config.Resources, key)
+		if err != nil {
+			return nil, err
+		}
+		selected = append(selected, address)
+	}
+
+	return targetAddresses(BundleToTerraform(&b.Config), selected, b.IncludeDependencies)
+}
+
 func (p *plan) Apply(ctx context.Context, b *bundle.Bundle) error {
 	tf := b.Terraform
 	if tf == nil {
@@ -46,8 +61,21 @@ func (p *plan) Apply(ctx context.Context, b *bundle.Bundle) error {
 	}
 	planPath := filepath.Join(tfDir, "plan")
 	destroy := p.goal == PlanDestroy
+	opts := []tfexec.PlanOption{tfexec.Destroy(destroy), tfexec.Out(planPath)}
+
+	// Limit the plan to the selected resources, if any.
+	if len(b.TargetResources) > 0 {
+		targets, err := p.targets(b)
+		if err != nil {
+			return err
+		}
+		cmdio.LogString(ctx, fmt.Sprintf("Limiting plan to %s", strings.Join(targets, ", ")))
+		for _, target := range targets {
+			opts = append(opts, tfexec.Target(target))
+		}
+	}
 
-	notEmpty, err := tf.Plan(ctx, tfexec.Destroy(destroy), tfexec.Out(planPath))
+	notEmpty, err := tf.Plan(ctx, opts...)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,89 @@
+package terraform
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/libs/cmdio"
+	tfjson "github.com/hashicorp/terraform-json"
+)
+
+// planChanges returns the resource changes in a plan that are not no-ops.
+func planChanges(changes []*tfjson.ResourceChange) []*PlanResourceChange {
+	var out []*PlanResourceChange
+	for _, c := range changes {
+		var action string
+		switch {
+		case c.Change.Actions.Replace():
+			action = "recreate"
+		case c.Change.Actions.Create():
+			action = "create"
+		case c.Change.Actions.Update():
+			action = "update"
+		case c.Change.Actions.Delete():
+			action = "delete"
+		default:
+			continue
+		}
+		out = append(out, &PlanResourceChange{
+			ResourceType: c.Type,
+			Action:       action,
+			ResourceName: c.Name,
+		})
+	}
+	return out
+}
+
+// PlanChanges returns the resource changes in the plan computed by [Plan].
+func PlanChanges(ctx context.Context, b *bundle.Bundle) ([]*PlanResourceChange, error) {
+	tf := b.Terraform
+	if tf == nil {
+		return nil, fmt.Errorf("terraform not initialized")
+	}
+
+	if b.Plan == nil || b.Plan.Path == "" {
+		return nil, fmt.Errorf("no plan found")
+	}
+
+	if b.Plan.IsEmpty {
+		return nil, nil
+	}
+
+	plan, err := tf.ShowPlanFile(ctx, b.Plan.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	return planChanges(plan.ResourceChanges), nil
+}
+
+type showPlan struct{}
+
+func (s *showPlan) Name() string {
+	return "terraform.ShowPlan"
+}
+
+func (s *showPlan) Apply(ctx context.Context, b *bundle.Bundle) error {
+	changes, err := PlanChanges(ctx, b)
+	if err != nil {
+		return err
+	}
+
+	if len(changes) == 0 {
+		cmdio.LogString(ctx, "No changes to resources")
+		return nil
+	}
+
+	cmdio.LogString(ctx, "The following resources will be changed:")
+	for _, c := range changes {
+		cmdio.Log(ctx, c)
+	}
+	return nil
+}
+
+// ShowPlan returns a [bundle.Mutator] that logs the resource changes
+// in the plan computed by [Plan].
+func ShowPlan() bundle.Mutator {
+	return &showPlan{}
+}
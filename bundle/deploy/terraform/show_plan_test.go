@@ -0,0 +1,51 @@
+package terraform
+
+import (
+	"testing"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlanChanges(t *testing.T) {
+	change := func(typ, name string, actions ...tfjson.Action) *tfjson.ResourceChange {
+		return &tfjson.ResourceChange{
+			Type:   typ,
+			Name:   name,
+			Change: &tfjson.Change{Actions: actions},
+		}
+	}
+
+	changes := planChanges([]*tfjson.ResourceChange{
+		change("databricks_job", "create", tfjson.ActionCreate),
+		change("databricks_job", "update", tfjson.ActionUpdate),
+		change("databricks_pipeline", "delete", tfjson.ActionDelete),
+		change("databricks_pipeline", "recreate", tfjson.ActionDelete, tfjson.ActionCreate),
+		change("databricks_cluster", "noop", tfjson.ActionNoop),
+	})
+
+	assert.Equal(t, []*PlanResourceChange{
+		{ResourceType: "databricks_job", Action: "create", ResourceName: "create"},
+		{ResourceType: "databricks_job", Action: "update", ResourceName: "update"},
+		{ResourceType: "databricks_pipeline", Action: "delete", ResourceName: "delete"},
+		{ResourceType: "databricks_pipeline", Action: "recreate", ResourceName: "recreate"},
+	}, changes)
+
+	assert.Equal(t, "create job create", changes[0].String())
+	assert.Equal(t, "recreate pipeline recreate", changes[3].String())
+}
+
+func TestPlanResourceChangeString(t *testing.T) {
+	for _, tc := range []struct {
+		change   PlanResourceChange
+		expected string
+	}{
+		{PlanResourceChange{"databricks_cluster", "create", "foo"}, "create cluster foo"},
+		{PlanResourceChange{"databricks_schema", "update", "foo"}, "update schema foo"},
+		{PlanResourceChange{"databricks_volume", "delete", "foo"}, "  delete volume foo"},
+		{PlanResourceChange{"databricks_model_serving", "recreate", "foo"}, "recreate model serving endpoint foo"},
+		{PlanResourceChange{"databricks_unknown", "create", "foo"}, "create databricks_unknown foo"},
+	} {
+		assert.Equal(t, tc.expected, tc.change.String())
+	}
+}
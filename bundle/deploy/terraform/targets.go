@@ -0,0 +1,94 @@
+package terraform
+
+import (
+	"encoding/json"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/databricks/cli/bundle/internal/tf/schema"
+)
+
+var resourceReferenceRegex = regexp.MustCompile(`\$\{(databricks_[a-z_]+\.[a-zA-Z0-9_-]+)\.`)
+
+// references returns a map of the address of every resource in the Terraform
+// configuration to the addresses of the resources it references.
+func references(root *schema.Root) (map[string]map[string]bool, error) {
+	out := make(map[string]map[string]bool)
+	if root.Resource == nil {
+		return out, nil
+	}
+
+	// Use the JSON representation to iterate over all resource types.
+	buf, err := json.Marshal(root.Resource)
+	if err != nil {
+		return nil, err
+	}
+	var resources map[string]map[string]json.RawMessage
+	err = json.Unmarshal(buf, &resources)
+	if err != nil {
+		return nil, err
+	}
+
+	for typ, byName := range resources {
+		for name, raw := range byName {
+			refs := make(map[string]bool)
+			for _, m := range resourceReferenceRegex.FindAllStringSubmatch(string(raw), -1) {
+				refs[m[1]] = true
+			}
+			out[typ+"."+name] = refs
+		}
+	}
+
+	return out, nil
+}
+
+// targetAddresses returns the addresses of the resources to limit a plan to.
+//
+// Permissions and grants are part of the resource they apply to and are always included.
+// If `includeDependencies` is set, resources that reference the selected resources are
+// included as well. Resources that the selected resources reference are always
+// included by Terraform itself.
+func targetAddresses(root *schema.Root, selected []string, includeDependencies bool) ([]string, error) {
+	refs, err := references(root)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(map[string]bool)
+	for _, address := range selected {
+		out[address] = true
+	}
+
+	// Returns true if the resource at the address references one of the targets.
+	referencesTarget := func(address string) bool {
+		for ref := range refs[address] {
+			if out[ref] {
+				return true
+			}
+		}
+		return false
+	}
+
+	for changed := true; changed; {
+		changed = false
+		for address := range refs {
+			if out[address] {
+				continue
+			}
+			isAccessControl := strings.HasPrefix(address, "databricks_permissions.") ||
+				strings.HasPrefix(address, "databricks_grants.")
+			if (isAccessControl || includeDependencies) && referencesTarget(address) {
+				out[address] = true
+				changed = true
+			}
+		}
+	}
+
+	addresses := make([]string, 0, len(out))
+	for address := range out {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+	return addresses, nil
+}
@@ -0,0 +1,68 @@
+package terraform
+
+import (
+	"testing"
+
+	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/cli/bundle/config/resources"
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func targetsTestConfig() *config.Root {
+	return &config.Root{
+		Resources: config.Resources{
+			Jobs: map[string]*resources.Job{
+				"upstream": {
+					Permissions: []resources.Permission{
+						{Level: "CAN_VIEW", GroupName: "users"},
+					},
+					JobSettings: &jobs.JobSettings{Name: "upstream"},
+				},
+				"downstream": {
+					JobSettings: &jobs.JobSettings{
+						Name: "downstream",
+						Tasks: []jobs.Task{
+							{
+								TaskKey: "notebook",
+								NotebookTask: &jobs.NotebookTask{
+									NotebookPath: "/notebook",
+									BaseParameters: map[string]string{
+										"upstream": "${databricks_job.upstream.id}",
+									},
+								},
+							},
+						},
+					},
+				},
+				"unrelated": {
+					JobSettings: &jobs.JobSettings{Name: "unrelated"},
+				},
+			},
+		},
+	}
+}
+
+func TestTargetAddresses(t *testing.T) {
+	root := BundleToTerraform(targetsTestConfig())
+
+	targets, err := targetAddresses(root, []string{"databricks_job.upstream"}, false)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"databricks_job.upstream",
+		"databricks_permissions.job_upstream",
+	}, targets)
+}
+
+func TestTargetAddressesIncludeDependencies(t *testing.T) {
+	root := BundleToTerraform(targetsTestConfig())
+
+	targets, err := targetAddresses(root, []string{"databricks_job.upstream"}, true)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"databricks_job.downstream",
+		"databricks_job.upstream",
+		"databricks_permissions.job_upstream",
+	}, targets)
+}
@@ -0,0 +1,187 @@
+package generate
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+	"github.com/databricks/databricks-sdk-go/service/pipelines"
+	"github.com/databricks/databricks-sdk-go/service/workspace"
+)
+
+// Downloader keeps track of notebooks and files referenced by resources
+// and downloads them into the bundle tree.
+//
+// It is the reverse of [mutator.TranslatePaths]: workspace paths in the
+// resource configuration are rewritten to local paths relative to the
+// directory the configuration file is written to.
+type Downloader struct {
+	w *databricks.WorkspaceClient
+
+	// Directory to download notebooks and files to.
+	sourceDir string
+
+	// Directory the resource configuration is written to.
+	configDir string
+
+	// Maps local paths to the workspace path they are downloaded from.
+	files map[string]string
+}
+
+func NewDownloader(w *databricks.WorkspaceClient, sourceDir, configDir string) *Downloader {
+	return &Downloader{
+		w:         w,
+		sourceDir: sourceDir,
+		configDir: configDir,
+		files:     make(map[string]string),
+	}
+}
+
+// markForDownload records that the workspace object at `*p` must be downloaded
+// and rewrites `*p` to the relative path of the local copy.
+func (d *Downloader) markForDownload(ctx context.Context, p *string, notebook bool) error {
+	// Only absolute paths refer to objects in the workspace.
+	if !path.IsAbs(*p) {
+		return nil
+	}
+
+	info, err := d.w.Workspace.GetStatusByPath(ctx, *p)
+	if err != nil {
+		return fmt.Errorf("unable to get status of %s: %w", *p, err)
+	}
+
+	name := path.Base(info.Path)
+	if notebook {
+		if info.ObjectType != workspace.ObjectTypeNotebook {
+			return fmt.Errorf("%s is not a notebook", *p)
+		}
+		name += notebookExtension(info.Language)
+	}
+
+	localPath := filepath.Join(d.sourceDir, name)
+	if other, ok := d.files[localPath]; ok && other != info.Path {
+		return fmt.Errorf("both %s and %s would be downloaded to %s", other, info.Path, localPath)
+	}
+	d.files[localPath] = info.Path
+
+	rel, err := filepath.Rel(d.configDir, localPath)
+	if err != nil {
+		return err
+	}
+
+	*p = filepath.ToSlash(rel)
+	return nil
+}
+
+// MarkJobForDownload marks the notebooks and files that the tasks of a job
+// reference for download and rewrites their paths.
+func (d *Downloader) MarkJobForDownload(ctx context.Context, job *jobs.JobSettings) error {
+	var err error
+
+	for i := range job.Tasks {
+		task := &job.Tasks[i]
+		if task.NotebookTask != nil {
+			err = d.markForDownload(ctx, &task.NotebookTask.NotebookPath, true)
+			if err != nil {
+				return err
+			}
+		}
+		if task.SparkPythonTask != nil {
+			err = d.markForDownload(ctx, &task.SparkPythonTask.PythonFile, false)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// MarkPipelineForDownload marks the notebooks and files that the libraries
+// of a pipeline reference for download and rewrites their paths.
+func (d *Downloader) MarkPipelineForDownload(ctx context.Context, spec *pipelines.PipelineSpec) error {
+	var err error
+
+	for i := range spec.Libraries {
+		library := &spec.Libraries[i]
+		if library.Notebook != nil {
+			err = d.markForDownload(ctx, &library.Notebook.Path, true)
+			if err != nil {
+				return err
+			}
+		}
+		if library.File != nil {
+			err = d.markForDownload(ctx, &library.File.Path, false)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// FlushToDisk downloads all marked notebooks and files.
+// Existing files are only overwritten if `force` is set.
+func (d *Downloader) FlushToDisk(ctx context.Context, force bool) error {
+	// Download in stable order.
+	localPaths := make([]string, 0, len(d.files))
+	for localPath := range d.files {
+		localPaths = append(localPaths, localPath)
+	}
+	sort.Strings(localPaths)
+
+	for _, localPath := range localPaths {
+		remotePath := d.files[localPath]
+		if _, err := os.Stat(localPath); err == nil && !force {
+			return fmt.Errorf("%s already exists; use --force to overwrite", localPath)
+		}
+
+		res, err := d.w.Workspace.Export(ctx, workspace.ExportRequest{
+			Path:   remotePath,
+			Format: workspace.ExportFormatSource,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to export %s: %w", remotePath, err)
+		}
+		buf, err := base64.StdEncoding.DecodeString(res.Content)
+		if err != nil {
+			return err
+		}
+
+		err = os.MkdirAll(filepath.Dir(localPath), 0755)
+		if err != nil {
+			return err
+		}
+		err = os.WriteFile(localPath, buf, 0644)
+		if err != nil {
+			return err
+		}
+
+		cmdio.LogString(ctx, fmt.Sprintf("File successfully saved to %s", localPath))
+	}
+
+	return nil
+}
+
+func notebookExtension(language workspace.Language) string {
+	switch language {
+	case workspace.LanguagePython:
+		return ".py"
+	case workspace.LanguageR:
+		return ".r"
+	case workspace.LanguageScala:
+		return ".scala"
+	case workspace.LanguageSql:
+		return ".sql"
+	default:
+		return ""
+	}
+}
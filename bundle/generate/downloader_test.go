@@ -0,0 +1,146 @@
+package generate
+
+import (
+	"context"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/databricks/cli/internal/testutil"
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+	"github.com/databricks/databricks-sdk-go/service/pipelines"
+	"github.com/databricks/databricks-sdk-go/service/workspace"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockWorkspace struct {
+	workspace.WorkspaceService
+
+	objects map[string]workspace.ObjectInfo
+	content map[string]string
+}
+
+func (m *mockWorkspace) GetStatus(ctx context.Context, req workspace.GetStatusRequest) (*workspace.ObjectInfo, error) {
+	info, ok := m.objects[req.Path]
+	if !ok {
+		return nil, os.ErrNotExist
+	}
+	return &info, nil
+}
+
+func (m *mockWorkspace) Export(ctx context.Context, req workspace.ExportRequest) (*workspace.ExportResponse, error) {
+	return &workspace.ExportResponse{
+		Content: base64.StdEncoding.EncodeToString([]byte(m.content[req.Path])),
+	}, nil
+}
+
+func mockWorkspaceClient() *databricks.WorkspaceClient {
+	impl := &mockWorkspace{
+		objects: map[string]workspace.ObjectInfo{
+			"/Users/jane@doe.com/notebook": {
+				Path:       "/Users/jane@doe.com/notebook",
+				ObjectType: workspace.ObjectTypeNotebook,
+				Language:   workspace.LanguagePython,
+			},
+			"/Users/jane@doe.com/script.py": {
+				Path:       "/Users/jane@doe.com/script.py",
+				ObjectType: workspace.ObjectTypeFile,
+			},
+			"/Users/john@doe.com/notebook": {
+				Path:       "/Users/john@doe.com/notebook",
+				ObjectType: workspace.ObjectTypeNotebook,
+				Language:   workspace.LanguagePython,
+			},
+		},
+		content: map[string]string{
+			"/Users/jane@doe.com/notebook":  "# Databricks notebook source\n",
+			"/Users/jane@doe.com/script.py": "print(1)\n",
+		},
+	}
+
+	return testutil.WorkspaceClient(testutil.WorkspaceServices{Workspace: impl})
+}
+
+func TestDownloaderJob(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	d := NewDownloader(mockWorkspaceClient(), filepath.Join(dir, "src"), filepath.Join(dir, "resources"))
+
+	job := &jobs.JobSettings{
+		Tasks: []jobs.Task{
+			{
+				TaskKey:      "notebook",
+				NotebookTask: &jobs.NotebookTask{NotebookPath: "/Users/jane@doe.com/notebook"},
+			},
+			{
+				TaskKey:         "script",
+				SparkPythonTask: &jobs.SparkPythonTask{PythonFile: "/Users/jane@doe.com/script.py"},
+			},
+			{
+				TaskKey:         "dbfs",
+				SparkPythonTask: &jobs.SparkPythonTask{PythonFile: "dbfs:/script.py"},
+			},
+		},
+	}
+
+	err := d.MarkJobForDownload(ctx, job)
+	require.NoError(t, err)
+	assert.Equal(t, "../src/notebook.py", job.Tasks[0].NotebookTask.NotebookPath)
+	assert.Equal(t, "../src/script.py", job.Tasks[1].SparkPythonTask.PythonFile)
+	assert.Equal(t, "dbfs:/script.py", job.Tasks[2].SparkPythonTask.PythonFile)
+
+	err = d.FlushToDisk(ctx, false)
+	require.NoError(t, err)
+	buf, err := os.ReadFile(filepath.Join(dir, "src", "notebook.py"))
+	require.NoError(t, err)
+	assert.Equal(t, "# Databricks notebook source\n", string(buf))
+	buf, err = os.ReadFile(filepath.Join(dir, "src", "script.py"))
+	require.NoError(t, err)
+	assert.Equal(t, "print(1)\n", string(buf))
+
+	// Files are not overwritten unless forced.
+	err = d.FlushToDisk(ctx, false)
+	assert.ErrorContains(t, err, "already exists")
+	err = d.FlushToDisk(ctx, true)
+	assert.NoError(t, err)
+}
+
+func TestDownloaderPipeline(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	d := NewDownloader(mockWorkspaceClient(), filepath.Join(dir, "src"), filepath.Join(dir, "resources"))
+
+	spec := &pipelines.PipelineSpec{
+		Libraries: []pipelines.PipelineLibrary{
+			{Notebook: &pipelines.NotebookLibrary{Path: "/Users/jane@doe.com/notebook"}},
+			{File: &pipelines.FileLibrary{Path: "/Users/jane@doe.com/script.py"}},
+		},
+	}
+
+	err := d.MarkPipelineForDownload(ctx, spec)
+	require.NoError(t, err)
+	assert.Equal(t, "../src/notebook.py", spec.Libraries[0].Notebook.Path)
+	assert.Equal(t, "../src/script.py", spec.Libraries[1].File.Path)
+}
+
+func TestDownloaderErrors(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	d := NewDownloader(mockWorkspaceClient(), filepath.Join(dir, "src"), filepath.Join(dir, "resources"))
+
+	// The file is not a notebook.
+	p := "/Users/jane@doe.com/script.py"
+	err := d.markForDownload(ctx, &p, true)
+	assert.ErrorContains(t, err, "is not a notebook")
+
+	// Two notebooks with the same name.
+	p = "/Users/jane@doe.com/notebook"
+	err = d.markForDownload(ctx, &p, true)
+	require.NoError(t, err)
+	p = "/Users/john@doe.com/notebook"
+	err = d.markForDownload(ctx, &p, true)
+	assert.ErrorContains(t, err, "would be downloaded to")
+}
@@ -0,0 +1,48 @@
+package generate
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+	"github.com/databricks/databricks-sdk-go/service/pipelines"
+	"github.com/ghodss/yaml"
+)
+
+var nonKeyRegex = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// ResourceKey derives a resource key from the name of a resource.
+func ResourceKey(name string) string {
+	key := nonKeyRegex.ReplaceAllString(strings.ToLower(name), "_")
+	return strings.Trim(key, "_")
+}
+
+// resourceConfig returns the YAML representation of a bundle configuration
+// file that defines a single resource of the specified kind.
+func resourceConfig(kind, key string, v any) ([]byte, error) {
+	if key == "" {
+		return nil, fmt.Errorf("resource key must not be empty")
+	}
+
+	return yaml.Marshal(map[string]any{
+		"resources": map[string]any{
+			kind: map[string]any{
+				key: v,
+			},
+		},
+	})
+}
+
+// JobConfig returns the bundle configuration for a job with the specified settings.
+func JobConfig(key string, job *jobs.JobSettings) ([]byte, error) {
+	return resourceConfig("jobs", key, job)
+}
+
+// PipelineConfig returns the bundle configuration for a pipeline with the specified spec.
+func PipelineConfig(key string, spec *pipelines.PipelineSpec) ([]byte, error) {
+	// The pipeline ID is assigned upon deployment.
+	copy := *spec
+	copy.Id = ""
+	return resourceConfig("pipelines", key, &copy)
+}
@@ -0,0 +1,57 @@
+package generate
+
+import (
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+	"github.com/databricks/databricks-sdk-go/service/pipelines"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceKey(t *testing.T) {
+	assert.Equal(t, "my_job", ResourceKey("My Job"))
+	assert.Equal(t, "nightly_etl_v2", ResourceKey("[nightly] ETL-v2"))
+}
+
+func TestJobConfig(t *testing.T) {
+	buf, err := JobConfig("my_job", &jobs.JobSettings{
+		Name: "My Job",
+		Tasks: []jobs.Task{
+			{
+				TaskKey:      "notebook",
+				NotebookTask: &jobs.NotebookTask{NotebookPath: "../src/notebook.py"},
+			},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, `resources:
+  jobs:
+    my_job:
+      name: My Job
+      tasks:
+      - notebook_task:
+          notebook_path: ../src/notebook.py
+        task_key: notebook
+`, string(buf))
+}
+
+func TestPipelineConfigOmitsId(t *testing.T) {
+	spec := &pipelines.PipelineSpec{
+		Id:   "1234",
+		Name: "My Pipeline",
+	}
+	buf, err := PipelineConfig("my_pipeline", spec)
+	require.NoError(t, err)
+	assert.Equal(t, `resources:
+  pipelines:
+    my_pipeline:
+      name: My Pipeline
+`, string(buf))
+	assert.Equal(t, "1234", spec.Id)
+}
+
+func TestResourceConfigRequiresKey(t *testing.T) {
+	_, err := JobConfig("", &jobs.JobSettings{})
+	assert.ErrorContains(t, err, "resource key must not be empty")
+}
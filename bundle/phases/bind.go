@@ -0,0 +1,51 @@
+package phases
+
+import (
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/deploy/lock"
+	"github.com/databricks/cli/bundle/deploy/terraform"
+)
+
+// The bind phase records that a resource in the bundle is deployed as an existing remote resource.
+func Bind(key, id string) bundle.Mutator {
+	bindMutator := bundle.Seq(
+		lock.Acquire(),
+		bundle.Defer(
+			bundle.Seq(
+				terraform.Interpolate(),
+				terraform.Write(),
+				terraform.StatePull(),
+				terraform.Import(key, id),
+				terraform.StatePush(),
+			),
+			lock.Release(lock.GoalBind),
+		),
+	)
+
+	return newPhase(
+		"bind",
+		[]bundle.Mutator{bindMutator},
+	)
+}
+
+// The unbind phase removes a resource from the deployment state without deleting it.
+func Unbind(key string) bundle.Mutator {
+	unbindMutator := bundle.Seq(
+		lock.Acquire(),
+		bundle.Defer(
+			bundle.Seq(
+				terraform.Interpolate(),
+				terraform.Write(),
+				terraform.StatePull(),
+				terraform.StateRm(key),
+				terraform.StatePush(),
+			),
+			lock.Release(lock.GoalUnbind),
+		),
+	)
+
+	return newPhase(
+		"unbind",
+		[]bundle.Mutator{unbindMutator},
+	)
+}
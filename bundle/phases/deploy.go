@@ -3,25 +3,39 @@ package phases
 import (
 	"github.com/databricks/cli/bundle"
 	"github.com/databricks/cli/bundle/artifacts"
+	"github.com/databricks/cli/bundle/artifacts/remote"
 	"github.com/databricks/cli/bundle/deploy/files"
+	"github.com/databricks/cli/bundle/deploy/history"
 	"github.com/databricks/cli/bundle/deploy/lock"
 	"github.com/databricks/cli/bundle/deploy/terraform"
 )
 
+// deployMutators returns the mutators of the deploy phase that run while the deployment lock is held.
+func deployMutators() []bundle.Mutator {
+	return []bundle.Mutator{
+		// Changes to resources are planned and approved before anything is uploaded,
+		// such that declining them leaves the previous deployment intact.
+		// This works because the remote paths of artifacts are computed by the build phase.
+		terraform.InterpolateForDeploy(),
+		terraform.Write(),
+		terraform.StatePull(),
+		terraform.Plan(terraform.PlanDeploy),
+		terraform.Approve(),
+		files.Upload(),
+		artifacts.UploadAll(),
+		terraform.Apply(),
+		terraform.StatePush(),
+		remote.CleanUp(),
+		history.Append(history.GoalDeploy),
+	}
+}
+
 // The deploy phase deploys artifacts and resources.
 func Deploy() bundle.Mutator {
 	deployMutator := bundle.Seq(
 		lock.Acquire(),
 		bundle.Defer(
-			bundle.Seq(
-				files.Upload(),
-				artifacts.UploadAll(),
-				terraform.Interpolate(),
-				terraform.Write(),
-				terraform.StatePull(),
-				terraform.Apply(),
-				terraform.StatePush(),
-			),
+			bundle.Seq(deployMutators()...),
 			lock.Release(lock.GoalDeploy),
 		),
 	)
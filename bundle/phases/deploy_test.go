@@ -0,0 +1,35 @@
+package phases
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/exp/slices"
+)
+
+func TestDeployApprovesBeforeUploading(t *testing.T) {
+	var names []string
+	for _, m := range deployMutators() {
+		names = append(names, m.Name())
+	}
+
+	approveIndex := slices.Index(names, "terraform.Approve")
+	assert.NotEqual(t, -1, approveIndex)
+	for _, name := range []string{"files.Upload", "artifacts.UploadAll", "terraform.Apply"} {
+		index := slices.Index(names, name)
+		assert.NotEqual(t, -1, index, name)
+		assert.Less(t, approveIndex, index, name)
+	}
+}
+
+func TestDeployCleansUpArtifactsBeforeRecordingHistory(t *testing.T) {
+	var names []string
+	for _, m := range deployMutators() {
+		names = append(names, m.Name())
+	}
+
+	// The clean up reads the previous deployment from the history.
+	cleanUpIndex := slices.Index(names, "remote.CleanUp")
+	assert.Less(t, slices.Index(names, "terraform.Apply"), cleanUpIndex)
+	assert.Less(t, cleanUpIndex, slices.Index(names, "history.Append"))
+}
@@ -3,25 +3,35 @@ package phases
 import (
 	"github.com/databricks/cli/bundle"
 	"github.com/databricks/cli/bundle/deploy/files"
+	"github.com/databricks/cli/bundle/deploy/history"
 	"github.com/databricks/cli/bundle/deploy/lock"
 	"github.com/databricks/cli/bundle/deploy/terraform"
 )
 
+// destroyMutators returns the mutators of the destroy phase that run while the deployment lock is held.
+func destroyMutators() []bundle.Mutator {
+	return []bundle.Mutator{
+		terraform.Interpolate(),
+		terraform.Write(),
+		terraform.StatePull(),
+		terraform.Plan(terraform.PlanGoal("destroy")),
+		terraform.Destroy(),
+		terraform.StatePush(),
+		// The record is written before deleting files because the state directory
+		// that holds the history is deleted along with the bundle root directory.
+		// It is retained if the files are not deleted, e.g. when destroying selected resources.
+		history.Append(history.GoalDestroy),
+		files.Delete(),
+	}
+}
+
 // The destroy phase deletes artifacts and resources.
 func Destroy() bundle.Mutator {
 
 	destroyMutator := bundle.Seq(
 		lock.Acquire(),
 		bundle.Defer(
-			bundle.Seq(
-				terraform.Interpolate(),
-				terraform.Write(),
-				terraform.StatePull(),
-				terraform.Plan(terraform.PlanGoal("destroy")),
-				terraform.Destroy(),
-				terraform.StatePush(),
-				files.Delete(),
-			),
+			bundle.Seq(destroyMutators()...),
 			lock.Release(lock.GoalDestroy),
 		),
 	)
@@ -0,0 +1,21 @@
+package phases
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/exp/slices"
+)
+
+func TestDestroyAppendsHistoryBeforeDeletingFiles(t *testing.T) {
+	var names []string
+	for _, m := range destroyMutators() {
+		names = append(names, m.Name())
+	}
+
+	appendIndex := slices.Index(names, "history.Append")
+	deleteIndex := slices.Index(names, "files.Delete")
+	assert.NotEqual(t, -1, appendIndex)
+	assert.NotEqual(t, -1, deleteIndex)
+	assert.Less(t, appendIndex, deleteIndex)
+}
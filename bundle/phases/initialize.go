@@ -25,6 +25,10 @@ func Initialize() bundle.Mutator {
 				interpolation.IncludeLookupsInPath("workspace"),
 				interpolation.IncludeLookupsInPath(variable.VariableReferencePrefix),
 			),
+			mutator.ResolveVariableReferences(),
+			mutator.ProcessEnvironmentMode(),
+			mutator.ApplyPermissions(),
+			mutator.ValidateRunAs(),
 			mutator.TranslatePaths(),
 			terraform.Initialize(),
 		},
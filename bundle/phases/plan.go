@@ -0,0 +1,23 @@
+package phases
+
+import (
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/deploy/files"
+	"github.com/databricks/cli/bundle/deploy/terraform"
+)
+
+// The plan phase reports the changes that the deploy phase would make
+// without making them.
+func Plan() bundle.Mutator {
+	return newPhase(
+		"plan",
+		[]bundle.Mutator{
+			files.Plan(),
+			terraform.InterpolateForDeploy(),
+			terraform.Write(),
+			terraform.StatePull(),
+			terraform.Plan(terraform.PlanDeploy),
+			terraform.ShowPlan(),
+		},
+	)
+}
@@ -38,6 +38,10 @@ type Schema struct {
 
 	// URI to a json schema
 	Reference *string `json:"$ref,omitempty"`
+
+	// Default value of the object. This is not set for generated schemas.
+	// It is used by schemas that describe the input parameters of a template.
+	Default any `json:"default,omitempty"`
 }
 
 // This function translates golang types into json schema. Here is the mapping
@@ -77,6 +81,7 @@ const (
 	Boolean JavascriptType = "boolean"
 	String  JavascriptType = "string"
 	Number  JavascriptType = "number"
+	Integer JavascriptType = "integer"
 	Object  JavascriptType = "object"
 	Array   JavascriptType = "array"
 )
@@ -239,7 +244,7 @@ func toSchema(golangType reflect.Type, docs *Docs, tracker *tracker) (*Schema, e
 		required := []string{}
 		for _, child := range children {
 			bundleTag := child.Tag.Get("bundle")
-			if bundleTag == "readonly" {
+			if bundleTag == "readonly" && !tracker.includeReadonly {
 				continue
 			}
 
@@ -15,6 +15,11 @@ type tracker struct {
 	//
 	// NOTE: node and node names can be the same
 	listOfNodes *list.List
+
+	// If set, fields tagged as readonly are included in the schema.
+	// These fields are not meant to be set by users, but they are decoded
+	// from the configuration nonetheless.
+	includeReadonly bool
 }
 
 func newTracker() *tracker {
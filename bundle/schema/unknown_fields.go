@@ -0,0 +1,181 @@
+package schema
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+)
+
+// UnknownField is a key in a configuration file that isn't defined in the schema.
+type UnknownField struct {
+	// Path of the mapping that contains the key, e.g. "resources.jobs.foo.tasks[0]".
+	Path string
+
+	// Key that isn't defined in the schema, e.g. "notebok_task".
+	Key string
+
+	// Position of the key in the configuration file.
+	Line   int
+	Column int
+
+	// Defined key that is most similar to the unknown key, if any.
+	Suggestion string
+}
+
+func (f UnknownField) String() string {
+	msg := fmt.Sprintf("unknown field %q", f.Key)
+	if f.Path != "" {
+		msg += fmt.Sprintf(" in %s", f.Path)
+	}
+	if f.Suggestion != "" {
+		msg += fmt.Sprintf("; did you mean %q?", f.Suggestion)
+	}
+	return msg
+}
+
+// UnknownFields returns all keys in the specified YAML document that are not
+// defined in the schema for the specified type, in order of appearance.
+// Unlike the schema returned by [New], fields tagged as readonly are known.
+func UnknownFields(golangType reflect.Type, node *yaml.Node) ([]UnknownField, error) {
+	tracker := newTracker()
+	tracker.includeReadonly = true
+	s, err := safeToSchema(golangType, nil, "", tracker)
+	if err != nil {
+		return nil, tracker.errWithTrace(err.Error(), "root")
+	}
+
+	var out []UnknownField
+	s.unknownFields(node, "", &out)
+	return out, nil
+}
+
+func (s *Schema) unknownFields(node *yaml.Node, path string, out *[]UnknownField) {
+	if s == nil {
+		return
+	}
+
+	switch node.Kind {
+	case yaml.DocumentNode:
+		for _, n := range node.Content {
+			s.unknownFields(n, path, out)
+		}
+	case yaml.AliasNode:
+		s.unknownFields(node.Alias, path, out)
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+
+			// Keys merged from an anchor are validated as if they were defined here.
+			if key.Value == "<<" && key.Tag == "!!merge" {
+				s.unknownFields(value, path, out)
+				continue
+			}
+
+			// Keys that define an anchor commonly exist only for that purpose.
+			// The anchored value is validated where it is referenced.
+			if value.Anchor != "" {
+				if _, ok := s.property(key.Value); !ok {
+					continue
+				}
+			}
+
+			child, ok := s.property(key.Value)
+			if !ok {
+				*out = append(*out, UnknownField{
+					Path:       path,
+					Key:        key.Value,
+					Line:       key.Line,
+					Column:     key.Column,
+					Suggestion: closestMatch(key.Value, s.propertyNames()),
+				})
+				continue
+			}
+
+			childPath := key.Value
+			if path != "" {
+				childPath = path + "." + key.Value
+			}
+			child.unknownFields(value, childPath, out)
+		}
+	case yaml.SequenceNode:
+		for i, value := range node.Content {
+			s.Items.unknownFields(value, fmt.Sprintf("%s[%d]", path, i), out)
+		}
+	}
+}
+
+// property returns the schema for the value of the specified key.
+// It returns false if the schema doesn't permit the key.
+func (s *Schema) property(key string) (*Schema, bool) {
+	if child, ok := s.Properties[key]; ok {
+		return child, true
+	}
+
+	switch v := s.AdditionalProperties.(type) {
+	case *Schema:
+		return v, true
+	case bool:
+		// Only structs disallow additional properties.
+		return nil, v
+	}
+
+	// Schemas without properties accept any value (e.g. for interface types).
+	return nil, s.Properties == nil
+}
+
+func (s *Schema) propertyNames() []string {
+	var names []string
+	for name := range s.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// closestMatch returns the candidate that is closest to the specified key.
+// It returns the empty string if no candidate is reasonably close.
+func closestMatch(key string, candidates []string) string {
+	best := ""
+	bestDistance := len(key)/2 + 1
+	for _, c := range candidates {
+		d := levenshtein(key, c)
+		if d < bestDistance {
+			best = c
+			bestDistance = d
+		}
+	}
+	return best
+}
+
+// levenshtein returns the edit distance between two strings.
+func levenshtein(a, b string) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = minInt(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
+
+func minInt(values ...int) int {
+	m := values[0]
+	for _, v := range values[1:] {
+		if v < m {
+			m = v
+		}
+	}
+	return m
+}
@@ -0,0 +1,66 @@
+package schema
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestUnknownFields(t *testing.T) {
+	type Inner struct {
+		Name string `json:"name"`
+	}
+
+	type Outer struct {
+		ID      string            `json:"id" bundle:"readonly"`
+		Inner   Inner             `json:"inner"`
+		List    []Inner           `json:"list"`
+		Map     map[string]Inner  `json:"map"`
+		Any     any               `json:"any"`
+		Strings map[string]string `json:"strings"`
+	}
+
+	raw := `
+id: "1234"
+inner:
+  nmae: foo
+list:
+  - name: foo
+  - name: bar
+    unknown: baz
+map:
+  foo:
+    name: foo
+any:
+  whatever: 1
+strings:
+  foo: bar
+inne:
+  name: foo
+`
+	var node yaml.Node
+	err := yaml.Unmarshal([]byte(raw), &node)
+	require.NoError(t, err)
+
+	fields, err := UnknownFields(reflect.TypeOf(Outer{}), &node)
+	require.NoError(t, err)
+	assert.Equal(t, []UnknownField{
+		{Path: "inner", Key: "nmae", Line: 4, Column: 3, Suggestion: "name"},
+		{Path: "list[1]", Key: "unknown", Line: 8, Column: 5},
+		{Path: "", Key: "inne", Line: 16, Column: 1, Suggestion: "inner"},
+	}, fields)
+
+	assert.Equal(t, `unknown field "nmae" in inner; did you mean "name"?`, fields[0].String())
+	assert.Equal(t, `unknown field "unknown" in list[1]`, fields[1].String())
+	assert.Equal(t, `unknown field "inne"; did you mean "inner"?`, fields[2].String())
+}
+
+func TestClosestMatch(t *testing.T) {
+	candidates := []string{"environments", "resources", "workspace"}
+	assert.Equal(t, "environments", closestMatch("enviroments", candidates))
+	assert.Equal(t, "resources", closestMatch("resource", candidates))
+	assert.Equal(t, "", closestMatch("foo", candidates))
+}
@@ -0,0 +1,178 @@
+package summary
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/cli/bundle/config/resources"
+)
+
+// Summary describes what a bundle deploys and where it is deployed to.
+type Summary struct {
+	Bundle    Bundle     `json:"bundle"`
+	Workspace Workspace  `json:"workspace"`
+	Resources []Resource `json:"resources"`
+}
+
+type Bundle struct {
+	Name        string `json:"name"`
+	Environment string `json:"environment,omitempty"`
+	Mode        string `json:"mode,omitempty"`
+}
+
+type Workspace struct {
+	Host         string `json:"host,omitempty"`
+	User         string `json:"user,omitempty"`
+	RootPath     string `json:"root_path,omitempty"`
+	FilesPath    string `json:"file_path,omitempty"`
+	ArtifactPath string `json:"artifact_path,omitempty"`
+	StatePath    string `json:"state_path,omitempty"`
+}
+
+// Resource describes a single resource in the bundle.
+// The ID and URL are only set if the resource has been deployed.
+type Resource struct {
+	Kind        string                 `json:"kind"`
+	Key         string                 `json:"key"`
+	Name        string                 `json:"name,omitempty"`
+	ID          string                 `json:"id,omitempty"`
+	URL         string                 `json:"url,omitempty"`
+	Permissions []resources.Permission `json:"permissions,omitempty"`
+	Grants      []resources.Grant      `json:"grants,omitempty"`
+}
+
+// resourceURL returns the URL of a deployed resource in the workspace.
+func resourceURL(host, kind, id string) string {
+	if host == "" || id == "" {
+		return ""
+	}
+
+	host = strings.TrimSuffix(host, "/")
+	switch kind {
+	case "jobs":
+		return fmt.Sprintf("%s/#job/%s", host, id)
+	case "pipelines":
+		return fmt.Sprintf("%s/#joblist/pipelines/%s", host, id)
+	case "models":
+		return fmt.Sprintf("%s/#mlflow/models/%s", host, id)
+	case "experiments":
+		return fmt.Sprintf("%s/#mlflow/experiments/%s", host, id)
+	case "model_serving_endpoints":
+		return fmt.Sprintf("%s/ml/endpoints/%s", host, id)
+	case "schemas":
+		return fmt.Sprintf("%s/explore/data/%s", host, strings.ReplaceAll(id, ".", "/"))
+	case "volumes":
+		return fmt.Sprintf("%s/explore/data/volumes/%s", host, strings.ReplaceAll(id, ".", "/"))
+	case "clusters":
+		return fmt.Sprintf("%s/#setting/clusters/%s/configuration", host, id)
+	default:
+		return ""
+	}
+}
+
+// New returns the summary of a bundle configuration.
+// The argument `host` is the URL of the workspace the bundle is deployed to.
+func New(c *config.Root, host string) *Summary {
+	s := &Summary{
+		Bundle: Bundle{
+			Name:        c.Bundle.Name,
+			Environment: c.Bundle.Environment,
+			Mode:        string(c.Bundle.Mode),
+		},
+		Workspace: Workspace{
+			Host:         host,
+			RootPath:     c.Workspace.RootPath,
+			FilesPath:    c.Workspace.FilesPath,
+			ArtifactPath: c.Workspace.ArtifactsPath,
+			StatePath:    c.Workspace.StatePath,
+		},
+		Resources: []Resource{},
+	}
+
+	if c.Workspace.CurrentUser != nil {
+		s.Workspace.User = c.Workspace.CurrentUser.UserName
+	}
+
+	add := func(kind, key, name, id string, permissions []resources.Permission) *Resource {
+		s.Resources = append(s.Resources, Resource{
+			Kind:        kind,
+			Key:         key,
+			Name:        name,
+			ID:          id,
+			URL:         resourceURL(host, kind, id),
+			Permissions: permissions,
+		})
+		return &s.Resources[len(s.Resources)-1]
+	}
+
+	r := c.Resources
+	for k, v := range r.Jobs {
+		var name string
+		if v.JobSettings != nil {
+			name = v.Name
+		}
+		add("jobs", k, name, v.ID, v.Permissions)
+	}
+	for k, v := range r.Pipelines {
+		var name string
+		if v.PipelineSpec != nil {
+			name = v.Name
+		}
+		add("pipelines", k, name, v.ID, v.Permissions)
+	}
+	for k, v := range r.Models {
+		var name string
+		if v.Model != nil {
+			name = v.Name
+		}
+		add("models", k, name, v.ID, v.Permissions)
+	}
+	for k, v := range r.Experiments {
+		var name string
+		if v.Experiment != nil {
+			name = v.Name
+		}
+		add("experiments", k, name, v.ID, v.Permissions)
+	}
+	for k, v := range r.ModelServingEndpoints {
+		var name string
+		if v.CreateServingEndpoint != nil {
+			name = v.Name
+		}
+		add("model_serving_endpoints", k, name, v.ID, v.Permissions)
+	}
+	for k, v := range r.Schemas {
+		var name string
+		if v.CreateSchema != nil {
+			name = v.Name
+		}
+		add("schemas", k, name, v.ID, nil).Grants = v.Grants
+	}
+	for k, v := range r.Volumes {
+		var name string
+		if v.CreateVolumeRequestContent != nil {
+			name = v.Name
+		}
+		add("volumes", k, name, v.ID, nil).Grants = v.Grants
+	}
+	for k, v := range r.Clusters {
+		var name string
+		if v.ClusterSpec != nil {
+			name = v.ClusterName
+		}
+		add("clusters", k, name, v.ID, v.Permissions)
+	}
+
+	// Sort resources by kind and key for stable output.
+	sort.Slice(s.Resources, func(i, j int) bool {
+		a, b := s.Resources[i], s.Resources[j]
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		return a.Key < b.Key
+	})
+
+	return s
+}
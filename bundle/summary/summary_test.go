@@ -0,0 +1,98 @@
+package summary
+
+import (
+	"testing"
+
+	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/cli/bundle/config/resources"
+	"github.com/databricks/databricks-sdk-go/service/catalog"
+	"github.com/databricks/databricks-sdk-go/service/iam"
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+	"github.com/databricks/databricks-sdk-go/service/pipelines"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSummary(t *testing.T) {
+	c := &config.Root{
+		Bundle: config.Bundle{
+			Name:        "my_bundle",
+			Environment: "dev",
+		},
+		Workspace: config.Workspace{
+			CurrentUser: &iam.User{UserName: "jane@doe.com"},
+			RootPath:    "/Users/jane@doe.com/.bundle/my_bundle/dev",
+			FilesPath:   "/Users/jane@doe.com/.bundle/my_bundle/dev/files",
+			StatePath:   "/Users/jane@doe.com/.bundle/my_bundle/dev/state",
+		},
+		Resources: config.Resources{
+			Jobs: map[string]*resources.Job{
+				"job2": {
+					JobSettings: &jobs.JobSettings{Name: "Job 2"},
+				},
+				"job1": {
+					ID: "1234",
+					Permissions: []resources.Permission{
+						{Level: "CAN_VIEW", GroupName: "users"},
+					},
+					JobSettings: &jobs.JobSettings{Name: "Job 1"},
+				},
+			},
+			Pipelines: map[string]*resources.Pipeline{
+				"pipeline": {
+					ID:           "abcd",
+					PipelineSpec: &pipelines.PipelineSpec{Name: "Pipeline"},
+				},
+			},
+			Schemas: map[string]*resources.Schema{
+				"schema": {
+					ID: "main.schema",
+					Grants: []resources.Grant{
+						{Principal: "users", Privileges: []string{"USE_SCHEMA"}},
+					},
+					CreateSchema: &catalog.CreateSchema{Name: "schema"},
+				},
+			},
+		},
+	}
+
+	s := New(c, "https://example.com/")
+	assert.Equal(t, "my_bundle", s.Bundle.Name)
+	assert.Equal(t, "dev", s.Bundle.Environment)
+	assert.Equal(t, "jane@doe.com", s.Workspace.User)
+	assert.Equal(t, "/Users/jane@doe.com/.bundle/my_bundle/dev/files", s.Workspace.FilesPath)
+
+	assert.Equal(t, []Resource{
+		{
+			Kind: "jobs",
+			Key:  "job1",
+			Name: "Job 1",
+			ID:   "1234",
+			URL:  "https://example.com/#job/1234",
+			Permissions: []resources.Permission{
+				{Level: "CAN_VIEW", GroupName: "users"},
+			},
+		},
+		{
+			Kind: "jobs",
+			Key:  "job2",
+			Name: "Job 2",
+		},
+		{
+			Kind: "pipelines",
+			Key:  "pipeline",
+			Name: "Pipeline",
+			ID:   "abcd",
+			URL:  "https://example.com/#joblist/pipelines/abcd",
+		},
+		{
+			Kind: "schemas",
+			Key:  "schema",
+			Name: "schema",
+			ID:   "main.schema",
+			URL:  "https://example.com/explore/data/main/schema",
+			Grants: []resources.Grant{
+				{Principal: "users", Privileges: []string{"USE_SCHEMA"}},
+			},
+		},
+	}, s.Resources)
+}
@@ -0,0 +1,186 @@
+package template
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+
+	"github.com/databricks/cli/bundle/schema"
+	"github.com/databricks/cli/libs/cmdio"
+)
+
+// SchemaFileName is the name of the file in the root of a template
+// that declares the input parameters of the template as a JSON schema.
+const SchemaFileName = "databricks_template_schema.json"
+
+type config struct {
+	schema *schema.Schema
+	values map[string]any
+}
+
+func newConfig(templateFS fs.FS) (*config, error) {
+	buf, err := fs.ReadFile(templateFS, SchemaFileName)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read template schema: %w", err)
+	}
+
+	var s schema.Schema
+	err = json.Unmarshal(buf, &s)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse template schema: %w", err)
+	}
+
+	for name, property := range s.Properties {
+		switch property.Type {
+		case schema.String, schema.Boolean, schema.Number, schema.Integer:
+		default:
+			return nil, fmt.Errorf("unsupported type %q for template parameter %s", property.Type, name)
+		}
+	}
+
+	return &config{
+		schema: &s,
+		values: make(map[string]any),
+	}, nil
+}
+
+// names returns the names of the parameters in stable order.
+func (c *config) names() []string {
+	names := make([]string, 0, len(c.schema.Properties))
+	for name := range c.schema.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// convert converts a value to the type of the parameter with the specified name.
+func (c *config) convert(name string, v any) (any, error) {
+	property, ok := c.schema.Properties[name]
+	if !ok {
+		return nil, fmt.Errorf("%s is not defined as an input parameter for the template", name)
+	}
+
+	switch property.Type {
+	case schema.String:
+		if s, ok := v.(string); ok {
+			return s, nil
+		}
+	case schema.Boolean:
+		if b, ok := v.(bool); ok {
+			return b, nil
+		}
+	case schema.Number:
+		if f, ok := v.(float64); ok {
+			return f, nil
+		}
+	case schema.Integer:
+		if f, ok := v.(float64); ok && f == math.Trunc(f) {
+			return int64(f), nil
+		}
+	}
+
+	return nil, fmt.Errorf("expected value of type %s for %s, got %#v", property.Type, name, v)
+}
+
+// parse converts a string read from the user to the type of the parameter with the specified name.
+func (c *config) parse(name string, s string) (any, error) {
+	property := c.schema.Properties[name]
+	switch property.Type {
+	case schema.Boolean:
+		return strconv.ParseBool(s)
+	case schema.Number:
+		return strconv.ParseFloat(s, 64)
+	case schema.Integer:
+		return strconv.ParseInt(s, 10, 64)
+	default:
+		return s, nil
+	}
+}
+
+// assignValuesFromFile assigns values from a JSON file that maps parameter names to values.
+func (c *config) assignValuesFromFile(path string) error {
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	var values map[string]any
+	err = json.Unmarshal(buf, &values)
+	if err != nil {
+		return fmt.Errorf("unable to parse config file %s: %w", path, err)
+	}
+
+	for name, v := range values {
+		c.values[name], err = c.convert(name, v)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// assignDefaultValues assigns default values to parameters that don't have a value yet.
+func (c *config) assignDefaultValues() error {
+	var err error
+	for _, name := range c.names() {
+		property := c.schema.Properties[name]
+		if _, ok := c.values[name]; ok || property.Default == nil {
+			continue
+		}
+		c.values[name], err = c.convert(name, property.Default)
+		if err != nil {
+			return fmt.Errorf("invalid default value: %w", err)
+		}
+	}
+	return nil
+}
+
+// promptForValues prompts the user for the value of parameters that don't have a value yet.
+// The default value of a parameter is suggested if it has one.
+func (c *config) promptForValues(ctx context.Context) error {
+	for _, name := range c.names() {
+		if _, ok := c.values[name]; ok {
+			continue
+		}
+
+		property := c.schema.Properties[name]
+		prompt := cmdio.Prompt(ctx)
+		prompt.Label = name
+		if property.Description != "" {
+			prompt.Label = property.Description
+		}
+		if property.Default != nil {
+			prompt.Default = fmt.Sprint(property.Default)
+		}
+		prompt.Validate = func(s string) error {
+			_, err := c.parse(name, s)
+			return err
+		}
+
+		s, err := prompt.Run()
+		if err != nil {
+			return fmt.Errorf("failed to read value for %s: %w", name, err)
+		}
+		c.values[name], err = c.parse(name, s)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validate returns an error if a parameter doesn't have a value.
+func (c *config) validate() error {
+	for _, name := range c.names() {
+		if _, ok := c.values[name]; !ok {
+			return fmt.Errorf("no value provided for input parameter %s", name)
+		}
+	}
+	return nil
+}
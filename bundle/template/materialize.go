@@ -0,0 +1,72 @@
+package template
+
+import (
+	"context"
+	"embed"
+	"io/fs"
+	"os"
+)
+
+//go:embed all:templates
+var builtinTemplates embed.FS
+
+// DefaultTemplateName is the name of the built-in template that is
+// used if no template path is specified.
+const DefaultTemplateName = "default"
+
+// builtinTemplate returns the file system of the built-in template with the specified name.
+func builtinTemplate(name string) (fs.FS, error) {
+	return fs.Sub(builtinTemplates, "templates/"+name)
+}
+
+// Materialize renders the template at `templatePath` into `outputDir`.
+// If `templatePath` is empty, the built-in default template is used.
+//
+// Values for the input parameters of the template are read from `configFilePath`
+// if specified. Otherwise, the user is prompted for them if possible.
+// Parameters without a value fall back to their default value.
+func Materialize(ctx context.Context, templatePath, configFilePath, outputDir string, prompt bool) error {
+	var templateFS fs.FS
+	var err error
+	if templatePath == "" {
+		templateFS, err = builtinTemplate(DefaultTemplateName)
+		if err != nil {
+			return err
+		}
+	} else {
+		templateFS = os.DirFS(templatePath)
+	}
+
+	config, err := newConfig(templateFS)
+	if err != nil {
+		return err
+	}
+
+	if configFilePath != "" {
+		err = config.assignValuesFromFile(configFilePath)
+		if err != nil {
+			return err
+		}
+	}
+
+	if prompt {
+		err = config.promptForValues(ctx)
+	} else {
+		err = config.assignDefaultValues()
+	}
+	if err != nil {
+		return err
+	}
+
+	err = config.validate()
+	if err != nil {
+		return err
+	}
+
+	r := &renderer{
+		templateFS: templateFS,
+		values:     config.values,
+		outputDir:  outputDir,
+	}
+	return r.render(ctx)
+}
@@ -0,0 +1,79 @@
+package template
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeConfigFile(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "config.json")
+	err := os.WriteFile(path, []byte(content), 0644)
+	require.NoError(t, err)
+	return path
+}
+
+func TestMaterializeDefaultTemplate(t *testing.T) {
+	dir := t.TempDir()
+	err := Materialize(testutil.Context(), "", "", dir, false)
+	require.NoError(t, err)
+
+	b, err := bundle.Load(filepath.Join(dir, "my_project"))
+	require.NoError(t, err)
+	assert.Equal(t, "my_project", b.Config.Bundle.Name)
+	assert.FileExists(t, filepath.Join(dir, "my_project", "src", "notebook.py"))
+	assert.FileExists(t, filepath.Join(dir, "my_project", "resources", "my_project_job.yml"))
+}
+
+func TestMaterializeWithConfigFile(t *testing.T) {
+	dir := t.TempDir()
+	configFile := writeConfigFile(t, `{"name": "foo", "enabled": true}`)
+	err := Materialize(testutil.Context(), "./testdata/simple", configFile, dir, false)
+	require.NoError(t, err)
+
+	buf, err := os.ReadFile(filepath.Join(dir, "foo", "config.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "name: foo\ncount: 3\n", string(buf))
+	assert.FileExists(t, filepath.Join(dir, "enabled.txt"))
+	assert.NoFileExists(t, filepath.Join(dir, SchemaFileName))
+}
+
+func TestMaterializeSkipsFilesWithEmptyPath(t *testing.T) {
+	dir := t.TempDir()
+	configFile := writeConfigFile(t, `{"name": "foo"}`)
+	err := Materialize(testutil.Context(), "./testdata/simple", configFile, dir, false)
+	require.NoError(t, err)
+	assert.FileExists(t, filepath.Join(dir, "foo", "config.txt"))
+	assert.NoFileExists(t, filepath.Join(dir, "enabled.txt"))
+}
+
+func TestMaterializeErrors(t *testing.T) {
+	ctx := testutil.Context()
+
+	// Parameter without a value and without a default.
+	err := Materialize(ctx, "./testdata/simple", "", t.TempDir(), false)
+	assert.ErrorContains(t, err, "no value provided for input parameter name")
+
+	// Parameter that isn't defined.
+	configFile := writeConfigFile(t, `{"name": "foo", "bar": "baz"}`)
+	err = Materialize(ctx, "./testdata/simple", configFile, t.TempDir(), false)
+	assert.ErrorContains(t, err, "bar is not defined as an input parameter for the template")
+
+	// Value of the wrong type.
+	configFile = writeConfigFile(t, `{"name": "foo", "count": 1.5}`)
+	err = Materialize(ctx, "./testdata/simple", configFile, t.TempDir(), false)
+	assert.ErrorContains(t, err, "expected value of type integer for count")
+
+	// Files are not overwritten.
+	dir := t.TempDir()
+	configFile = writeConfigFile(t, `{"name": "foo"}`)
+	err = Materialize(ctx, "./testdata/simple", configFile, dir, false)
+	require.NoError(t, err)
+	err = Materialize(ctx, "./testdata/simple", configFile, dir, false)
+	assert.ErrorContains(t, err, "already exists")
+}
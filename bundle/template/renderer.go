@@ -0,0 +1,114 @@
+package template
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/databricks/cli/libs/cmdio"
+)
+
+type renderer struct {
+	templateFS fs.FS
+	values     map[string]any
+	outputDir  string
+}
+
+func (r *renderer) execute(name, text string) (string, error) {
+	tmpl, err := template.New(name).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", err
+	}
+
+	var out bytes.Buffer
+	err = tmpl.Execute(&out, r.values)
+	if err != nil {
+		return "", err
+	}
+	return out.String(), nil
+}
+
+type file struct {
+	path    string
+	content string
+}
+
+// renderFile renders both the path and the contents of a template file.
+// It returns nil if the file is skipped.
+func (r *renderer) renderFile(name string) (*file, error) {
+	relPath, err := r.execute(name, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render path of %s: %w", name, err)
+	}
+
+	// Files with a path that has an empty component are skipped.
+	// This allows templates to conditionally include files and directories.
+	for _, component := range strings.Split(relPath, "/") {
+		if strings.TrimSpace(component) == "" {
+			return nil, nil
+		}
+	}
+	relPath = path.Clean(relPath)
+	if relPath == ".." || strings.HasPrefix(relPath, "../") {
+		return nil, fmt.Errorf("path of %s renders to %s, which is outside the output directory", name, relPath)
+	}
+
+	buf, err := fs.ReadFile(r.templateFS, name)
+	if err != nil {
+		return nil, err
+	}
+	content, err := r.execute(name, string(buf))
+	if err != nil {
+		return nil, fmt.Errorf("failed to render %s: %w", name, err)
+	}
+
+	return &file{
+		path:    filepath.Join(r.outputDir, filepath.FromSlash(relPath)),
+		content: content,
+	}, nil
+}
+
+// render renders all files in the template and writes them to the output directory.
+// Nothing is written if any of the files fails to render or already exists.
+func (r *renderer) render(ctx context.Context) error {
+	var files []*file
+	err := fs.WalkDir(r.templateFS, ".", func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || name == SchemaFileName {
+			return nil
+		}
+		f, err := r.renderFile(name)
+		if err != nil || f == nil {
+			return err
+		}
+		if _, err := os.Stat(f.path); err == nil {
+			return fmt.Errorf("%s already exists", f.path)
+		}
+		files = append(files, f)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, f := range files {
+		err = os.MkdirAll(filepath.Dir(f.path), 0755)
+		if err != nil {
+			return err
+		}
+		err = os.WriteFile(f.path, []byte(f.content), 0644)
+		if err != nil {
+			return err
+		}
+		cmdio.LogString(ctx, fmt.Sprintf("Created %s", f.path))
+	}
+	return nil
+}
@@ -0,0 +1,9 @@
+# Databricks notebook source
+# MAGIC %md
+# MAGIC # Sample notebook for {{.project_name}}
+# MAGIC
+# MAGIC This notebook is run by the {{.project_name}}_job job defined in resources/{{.project_name}}_job.yml.
+
+# COMMAND ----------
+
+print("Hello from {{.project_name}}!")
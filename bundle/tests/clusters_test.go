@@ -0,0 +1,33 @@
+package config_tests
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClustersDevelopment(t *testing.T) {
+	b := loadEnvironment(t, "./clusters", "development")
+	require.Len(t, b.Config.Resources.Clusters, 1)
+
+	c := b.Config.Resources.Clusters["shared"]
+	assert.Equal(t, "clusters/bundle.yml", filepath.ToSlash(c.ConfigFilePath))
+	assert.Equal(t, "shared-interactive-dev", c.ClusterName)
+	assert.Equal(t, 1, c.NumWorkers)
+	require.Len(t, c.Permissions, 1)
+	assert.Equal(t, "CAN_RESTART", c.Permissions[0].Level)
+
+	j := b.Config.Resources.Jobs["my_job"]
+	assert.Equal(t, "${resources.clusters.shared.id}", j.Tasks[0].ExistingClusterId)
+}
+
+func TestClustersProduction(t *testing.T) {
+	b := loadEnvironment(t, "./clusters", "production")
+	require.Len(t, b.Config.Resources.Clusters, 1)
+
+	c := b.Config.Resources.Clusters["shared"]
+	assert.Equal(t, "shared-interactive-prod", c.ClusterName)
+	assert.Equal(t, 4, c.NumWorkers)
+}
@@ -15,7 +15,7 @@ import (
 func TestConflictingResourceIdsNoSubconfig(t *testing.T) {
 	_, err := bundle.Load("./conflicting_resource_ids/no_subconfigurations")
 	bundleConfigPath := filepath.FromSlash("conflicting_resource_ids/no_subconfigurations/bundle.yml")
-	assert.ErrorContains(t, err, fmt.Sprintf("multiple resources named foo (job at %s, pipeline at %s)", bundleConfigPath, bundleConfigPath))
+	assert.ErrorContains(t, err, fmt.Sprintf("multiple resources named foo (job at %s:9:5, pipeline at %s:12:5)", bundleConfigPath, bundleConfigPath))
 }
 
 func TestConflictingResourceIdsOneSubconfig(t *testing.T) {
@@ -24,7 +24,7 @@ func TestConflictingResourceIdsOneSubconfig(t *testing.T) {
 	err = bundle.Apply(context.Background(), b, bundle.Seq(mutator.DefaultMutators()...))
 	bundleConfigPath := filepath.FromSlash("conflicting_resource_ids/one_subconfiguration/bundle.yml")
 	resourcesConfigPath := filepath.FromSlash("conflicting_resource_ids/one_subconfiguration/resources.yml")
-	assert.ErrorContains(t, err, fmt.Sprintf("multiple resources named foo (job at %s, pipeline at %s)", bundleConfigPath, resourcesConfigPath))
+	assert.ErrorContains(t, err, fmt.Sprintf("multiple resources named foo (job at %s:9:5, pipeline at %s:3:5)", bundleConfigPath, resourcesConfigPath))
 }
 
 func TestConflictingResourceIdsTwoSubconfigs(t *testing.T) {
@@ -33,5 +33,5 @@ func TestConflictingResourceIdsTwoSubconfigs(t *testing.T) {
 	err = bundle.Apply(context.Background(), b, bundle.Seq(mutator.DefaultMutators()...))
 	resources1ConfigPath := filepath.FromSlash("conflicting_resource_ids/two_subconfigurations/resources1.yml")
 	resources2ConfigPath := filepath.FromSlash("conflicting_resource_ids/two_subconfigurations/resources2.yml")
-	assert.ErrorContains(t, err, fmt.Sprintf("multiple resources named foo (job at %s, pipeline at %s)", resources1ConfigPath, resources2ConfigPath))
+	assert.ErrorContains(t, err, fmt.Sprintf("multiple resources named foo (job at %s:3:5, pipeline at %s:3:5)", resources1ConfigPath, resources2ConfigPath))
 }
@@ -0,0 +1,51 @@
+package config_tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config/mutator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvironmentExtendsBase(t *testing.T) {
+	b := loadEnvironment(t, "./environment_extends", "base")
+	assert.Equal(t, "https://acme.cloud.databricks.com/", b.Config.Workspace.Host)
+	assert.Equal(t, "base", b.Config.Workspace.Profile)
+	assert.Equal(t, "base", b.Config.Variables["catalog"].Default)
+	assert.Equal(t, 2, b.Config.Resources.Jobs["my_job"].MaxConcurrentRuns)
+}
+
+func TestEnvironmentExtendsStaging(t *testing.T) {
+	b := loadEnvironment(t, "./environment_extends", "staging")
+	assert.Equal(t, "https://staging.acme.cloud.databricks.com/", b.Config.Workspace.Host)
+	assert.Equal(t, "base", b.Config.Workspace.Profile)
+	assert.Equal(t, "staging", b.Config.Variables["catalog"].Default)
+	assert.Equal(t, 2, b.Config.Resources.Jobs["my_job"].MaxConcurrentRuns)
+}
+
+func TestEnvironmentExtendsProd(t *testing.T) {
+	b := loadEnvironment(t, "./environment_extends", "prod")
+	assert.Equal(t, "https://prod.acme.cloud.databricks.com/", b.Config.Workspace.Host)
+	assert.Equal(t, "base", b.Config.Workspace.Profile)
+	assert.Equal(t, "staging", b.Config.Variables["catalog"].Default)
+	assert.Equal(t, 2, b.Config.Resources.Jobs["my_job"].MaxConcurrentRuns)
+	assert.Equal(t, 3600, b.Config.Resources.Jobs["my_job"].TimeoutSeconds)
+	assert.Equal(t, "prod", b.Config.Bundle.Environment)
+}
+
+func TestEnvironmentExtendsEmpty(t *testing.T) {
+	b := loadEnvironment(t, "./environment_extends", "empty")
+	assert.Equal(t, "https://acme.cloud.databricks.com/", b.Config.Workspace.Host)
+	assert.Equal(t, "base", b.Config.Workspace.Profile)
+	assert.Equal(t, 2, b.Config.Resources.Jobs["my_job"].MaxConcurrentRuns)
+}
+
+func TestEnvironmentExtendsCycle(t *testing.T) {
+	b, err := bundle.Load("./environment_extends_cycle")
+	require.NoError(t, err)
+	err = bundle.Apply(context.Background(), b, bundle.Seq(mutator.DefaultMutators()...))
+	assert.ErrorContains(t, err, "cycle in environment inheritance: base -> prod -> staging -> base")
+}
@@ -0,0 +1,23 @@
+package config_tests
+
+import (
+	"testing"
+
+	"github.com/databricks/cli/bundle/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnvironmentModeDevelopment(t *testing.T) {
+	b := loadEnvironment(t, "./environment_mode", "development")
+	assert.Equal(t, config.Development, b.Config.Bundle.Mode)
+}
+
+func TestEnvironmentModeInherited(t *testing.T) {
+	b := loadEnvironment(t, "./environment_mode", "personal")
+	assert.Equal(t, config.Development, b.Config.Bundle.Mode)
+}
+
+func TestEnvironmentModeNotSet(t *testing.T) {
+	b := loadEnvironment(t, "./environment_mode", "production")
+	assert.Equal(t, config.Mode(""), b.Config.Bundle.Mode)
+}
@@ -0,0 +1,68 @@
+package config_tests
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config/interpolation"
+	"github.com/databricks/cli/bundle/config/mutator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocations(t *testing.T) {
+	b := loadEnvironment(t, "./locations", "development")
+	bundlePath := filepath.Join("locations", "bundle.yml")
+	resourcesPath := filepath.Join("locations", "resources.yml")
+
+	loc, ok := b.Config.Location("resources.jobs.my_job")
+	require.True(t, ok)
+	assert.Equal(t, bundlePath+":6:5", loc.String())
+
+	loc, ok = b.Config.Location("resources.jobs.my_job.tasks[0].notebook_task.notebook_path")
+	require.True(t, ok)
+	assert.Equal(t, bundlePath+":11:13", loc.String())
+
+	loc, ok = b.Config.Location("resources.pipelines.my_pipeline.libraries[0]")
+	require.True(t, ok)
+	assert.Equal(t, resourcesPath+":6:11", loc.String())
+
+	// Values without a location resolve to their closest enclosing value.
+	loc, ok = b.Config.Location("resources.jobs.my_job.tasks[0].max_retries")
+	require.True(t, ok)
+	assert.Equal(t, bundlePath+":9:11", loc.String())
+
+	_, ok = b.Config.Location("workspace.host")
+	assert.False(t, ok)
+}
+
+func TestLocationsInEnvironment(t *testing.T) {
+	b := loadEnvironment(t, "./locations", "staging")
+	bundlePath := filepath.Join("locations", "bundle.yml")
+
+	// Task key2 is appended to the tasks defined in the root.
+	loc, ok := b.Config.Location("resources.jobs.my_job.tasks[1].spark_python_task.python_file")
+	require.True(t, ok)
+	assert.Equal(t, bundlePath+":24:17", loc.String())
+
+	// Task key1 is merged with the task defined in the root.
+	loc, ok = b.Config.Location("resources.jobs.my_job.tasks[0].existing_cluster_id")
+	require.True(t, ok)
+	assert.Equal(t, bundlePath+":26:15", loc.String())
+}
+
+func TestLocationsInTranslatePathsError(t *testing.T) {
+	b := loadEnvironment(t, "./locations", "development")
+	err := bundle.Apply(context.Background(), b, mutator.TranslatePaths())
+	assert.ErrorContains(t, err, filepath.Join("locations", "bundle.yml")+":11:13: notebook ./does_not_exist.py not found")
+}
+
+func TestLocationsInInterpolationError(t *testing.T) {
+	b := loadEnvironment(t, "./locations", "staging")
+	err := bundle.Apply(context.Background(), b, interpolation.Interpolate(
+		interpolation.IncludeLookupsInPath("bundle"),
+	))
+	assert.ErrorContains(t, err, filepath.Join("locations", "bundle.yml")+":26:15: could not resolve reference bundle.does_not_exist")
+}
@@ -0,0 +1,59 @@
+package config_tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config/mutator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOverrideJobTasksDevelopment(t *testing.T) {
+	b := loadEnvironment(t, "./override_job_tasks", "development")
+	err := bundle.Apply(context.Background(), b, bundle.Seq(
+		mutator.SetVariables(),
+		mutator.ResolveVariableReferences(),
+	))
+	require.NoError(t, err)
+
+	j := b.Config.Resources.Jobs["foo"]
+
+	// Job cluster key1 is overridden, key2 is untouched.
+	require.Len(t, j.JobClusters, 2)
+	assert.Equal(t, "key1", j.JobClusters[0].JobClusterKey)
+	assert.Equal(t, "13.1.x-scala2.12", j.JobClusters[0].NewCluster.SparkVersion)
+	assert.Equal(t, "m5.xlarge", j.JobClusters[0].NewCluster.NodeTypeId)
+	assert.Equal(t, 1, j.JobClusters[0].NewCluster.NumWorkers)
+	assert.Equal(t, "key2", j.JobClusters[1].JobClusterKey)
+	assert.Equal(t, "i3.2xlarge", j.JobClusters[1].NewCluster.NodeTypeId)
+
+	// Task key1 is overridden, key2 is untouched, and key3 is added.
+	require.Len(t, j.Tasks, 3)
+	assert.Equal(t, "key1", j.Tasks[0].TaskKey)
+	assert.Equal(t, "./test1.py", j.Tasks[0].NotebookTask.NotebookPath)
+	assert.Equal(t, 3, j.Tasks[0].MaxRetries)
+	assert.Equal(t, "key2", j.Tasks[1].TaskKey)
+	assert.Equal(t, "./test2.py", j.Tasks[1].SparkPythonTask.PythonFile)
+	assert.Equal(t, "key3", j.Tasks[2].TaskKey)
+	assert.Equal(t, "./test3.py", j.Tasks[2].NotebookTask.NotebookPath)
+}
+
+func TestOverrideJobTasksStaging(t *testing.T) {
+	b := loadEnvironment(t, "./override_job_tasks", "staging")
+	j := b.Config.Resources.Jobs["foo"]
+
+	// Job cluster key2 is removed.
+	require.Len(t, j.JobClusters, 1)
+	assert.Equal(t, "key1", j.JobClusters[0].JobClusterKey)
+	assert.Equal(t, "i3.xlarge", j.JobClusters[0].NewCluster.NodeTypeId)
+
+	// Task key1 is overridden and key2 is removed.
+	require.Len(t, j.Tasks, 1)
+	assert.Equal(t, "key1", j.Tasks[0].TaskKey)
+	assert.Equal(t, "staging-cluster", j.Tasks[0].ExistingClusterId)
+	assert.Equal(t, "./test1.py", j.Tasks[0].NotebookTask.NotebookPath)
+	assert.Nil(t, j.RemoveTasks)
+	assert.Nil(t, j.RemoveJobClusters)
+}
@@ -0,0 +1,35 @@
+package config_tests
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOverridePipelineClusterDevelopment(t *testing.T) {
+	b := loadEnvironment(t, "./override_pipeline_cluster", "development")
+	p := b.Config.Resources.Pipelines["foo"]
+
+	require.Len(t, p.Clusters, 3)
+	assert.Equal(t, "default", p.Clusters[0].Label)
+	assert.Equal(t, "i3.xlarge", p.Clusters[0].NodeTypeId)
+	assert.Equal(t, 2, p.Clusters[0].NumWorkers)
+	assert.Equal(t, "bar", p.Clusters[0].SparkConf["foo"])
+	assert.Equal(t, "maintenance", p.Clusters[1].Label)
+	assert.Equal(t, 1, p.Clusters[1].NumWorkers)
+	assert.Equal(t, "extra", p.Clusters[2].Label)
+	assert.Equal(t, 8, p.Clusters[2].NumWorkers)
+}
+
+func TestOverridePipelineClusterStaging(t *testing.T) {
+	b := loadEnvironment(t, "./override_pipeline_cluster", "staging")
+	p := b.Config.Resources.Pipelines["foo"]
+
+	require.Len(t, p.Clusters, 1)
+	assert.Equal(t, "default", p.Clusters[0].Label)
+	assert.Equal(t, "i3.2xlarge", p.Clusters[0].NodeTypeId)
+	assert.Equal(t, 4, p.Clusters[0].NumWorkers)
+	assert.Equal(t, "bar", p.Clusters[0].SparkConf["foo"])
+	assert.Nil(t, p.RemoveClusters)
+}
@@ -0,0 +1,47 @@
+package config_tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config/mutator"
+	"github.com/databricks/cli/bundle/config/resources"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPermissionsDevelopment(t *testing.T) {
+	b := loadEnvironment(t, "./permissions", "development")
+	err := bundle.Apply(context.Background(), b, mutator.ApplyPermissions())
+	require.NoError(t, err)
+
+	assert.Equal(t, []resources.Permission{
+		{Level: "CAN_VIEW", UserName: "jane@example.com"},
+		{Level: "CAN_MANAGE", GroupName: "oncall"},
+	}, b.Config.Resources.Jobs["my_job"].Permissions)
+	assert.Equal(t, []resources.Permission{
+		{Level: "CAN_MANAGE", GroupName: "oncall"},
+	}, b.Config.Resources.Pipelines["my_pipeline"].Permissions)
+}
+
+func TestPermissionsProduction(t *testing.T) {
+	b := loadEnvironment(t, "./permissions", "production")
+	err := bundle.Apply(context.Background(), b, mutator.ApplyPermissions())
+	require.NoError(t, err)
+
+	assert.Equal(t, []resources.Permission{
+		{Level: "CAN_MANAGE", GroupName: "oncall"},
+		{Level: "CAN_VIEW", GroupName: "auditors"},
+	}, b.Config.Resources.Pipelines["my_pipeline"].Permissions)
+	// Levels are mapped to the equivalent level of each resource type.
+	assert.Equal(t, []resources.Permission{
+		{Level: "CAN_MANAGE", GroupName: "oncall"},
+		{Level: "CAN_READ", GroupName: "auditors"},
+	}, b.Config.Resources.Models["my_model"].Permissions)
+
+	// Clusters don't have a level that only allows viewing.
+	assert.Equal(t, []resources.Permission{
+		{Level: "CAN_MANAGE", GroupName: "oncall"},
+	}, b.Config.Resources.Clusters["my_cluster"].Permissions)
+}
@@ -0,0 +1,19 @@
+package config_tests
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunAsDevelopment(t *testing.T) {
+	b := loadEnvironment(t, "./run_as", "development")
+	assert.Equal(t, "jane@doe.com", b.Config.RunAs.UserName)
+	assert.Equal(t, "", b.Config.RunAs.ServicePrincipalName)
+}
+
+func TestRunAsProduction(t *testing.T) {
+	b := loadEnvironment(t, "./run_as", "production")
+	assert.Equal(t, "", b.Config.RunAs.UserName)
+	assert.Equal(t, "my-service-principal", b.Config.RunAs.ServicePrincipalName)
+}
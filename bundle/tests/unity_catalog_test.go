@@ -0,0 +1,39 @@
+package config_tests
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/service/catalog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnityCatalogSchemaAndVolume(t *testing.T) {
+	b := load(t, "./unity_catalog")
+	require.Len(t, b.Config.Resources.Schemas, 1)
+	require.Len(t, b.Config.Resources.Volumes, 1)
+
+	s := b.Config.Resources.Schemas["raw"]
+	assert.Equal(t, "unity_catalog/bundle.yml", filepath.ToSlash(s.ConfigFilePath))
+	assert.Equal(t, "raw", s.Name)
+	assert.Equal(t, "main", s.CatalogName)
+	require.Len(t, s.Grants, 1)
+	assert.Equal(t, "data-engineers", s.Grants[0].Principal)
+	assert.Equal(t, []string{"USE_SCHEMA", "CREATE_TABLE"}, s.Grants[0].Privileges)
+
+	v := b.Config.Resources.Volumes["landing"]
+	assert.Equal(t, "unity_catalog/bundle.yml", filepath.ToSlash(v.ConfigFilePath))
+	assert.Equal(t, "${resources.schemas.raw.name}", v.SchemaName)
+	assert.Equal(t, catalog.VolumeTypeManaged, v.VolumeType)
+	require.Len(t, v.Grants, 1)
+	assert.Equal(t, "data-scientists", v.Grants[0].Principal)
+	assert.Equal(t, []string{"READ_VOLUME"}, v.Grants[0].Privileges)
+}
+
+func TestUnityCatalogSchemaAndVolumeDevelopment(t *testing.T) {
+	b := loadEnvironment(t, "./unity_catalog", "development")
+	assert.Equal(t, "main", b.Config.Resources.Schemas["raw"].CatalogName)
+	assert.Equal(t, map[string]string{"environment": "development"}, b.Config.Resources.Schemas["raw"].Properties)
+	assert.Equal(t, "Landing zone for development", b.Config.Resources.Volumes["landing"].Comment)
+}
@@ -0,0 +1,25 @@
+package config_tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config/mutator"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnknownFields(t *testing.T) {
+	b := load(t, "./unknown_fields")
+	err := bundle.Apply(context.Background(), b, mutator.ValidateUnknownFields())
+	assert.ErrorContains(t, err, `bundle.yml:13:11: unknown field "notebok_task" in resources.jobs.my_job.tasks[0]; did you mean "notebook_task"?`)
+	assert.ErrorContains(t, err, `bundle.yml:16:1: unknown field "enviroments"; did you mean "environments"?`)
+	assert.ErrorContains(t, err, `resources.yml:8:7: unknown field "xyzzy" in resources.pipelines.my_pipeline`)
+	assert.NotContains(t, err.Error(), `"xyzzy" in resources.pipelines.my_pipeline;`)
+}
+
+func TestUnknownFieldsNone(t *testing.T) {
+	b := load(t, "./basic")
+	err := bundle.Apply(context.Background(), b, mutator.ValidateUnknownFields())
+	assert.NoError(t, err)
+}
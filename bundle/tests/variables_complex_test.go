@@ -0,0 +1,94 @@
+package config_tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config/interpolation"
+	"github.com/databricks/cli/bundle/config/mutator"
+	"github.com/databricks/cli/bundle/config/variable"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func loadComplexVariables(t *testing.T, env string, vars ...string) *bundle.Bundle {
+	b := load(t, "./variables/complex")
+	err := b.Config.InitializeVariables(vars)
+	require.NoError(t, err)
+	err = bundle.Apply(context.Background(), b, bundle.Seq(
+		mutator.SelectEnvironment(env),
+		mutator.SetVariables(),
+		interpolation.Interpolate(
+			interpolation.IncludeLookupsInPath("bundle"),
+			interpolation.IncludeLookupsInPath(variable.VariableReferencePrefix),
+		),
+		mutator.ResolveVariableReferences(),
+	))
+	require.NoError(t, err)
+	return b
+}
+
+func TestComplexVariablesDefault(t *testing.T) {
+	b := loadComplexVariables(t, "staging")
+	j := b.Config.Resources.Jobs["my_job"]
+
+	assert.Equal(t, "complex job", j.Name)
+	assert.Equal(t, map[string]string{"team": "data", "cost_center": "1234"}, j.Tags)
+	require.Len(t, j.JobClusters, 1)
+	assert.Equal(t, 2, j.JobClusters[0].NewCluster.NumWorkers)
+	assert.False(t, j.JobClusters[0].NewCluster.EnableElasticDisk)
+	require.Len(t, j.Tasks, 1)
+	assert.Equal(t, []string{"--env", "staging"}, j.Tasks[0].SparkPythonTask.Parameters)
+	assert.Equal(t, `Runs with 2 workers and tags {"cost_center":"1234","team":"data"}`, j.Tasks[0].Description)
+}
+
+func TestComplexVariablesEnvironmentOverride(t *testing.T) {
+	b := loadComplexVariables(t, "development")
+	j := b.Config.Resources.Jobs["my_job"]
+
+	assert.Equal(t, map[string]string{"team": "dev"}, j.Tags)
+	assert.Equal(t, 1, j.JobClusters[0].NewCluster.NumWorkers)
+	assert.Equal(t, []string{"--env", "development"}, j.Tasks[0].SparkPythonTask.Parameters)
+}
+
+func TestComplexVariablesInEnvironmentResources(t *testing.T) {
+	b := loadComplexVariables(t, "production")
+	j := b.Config.Resources.Jobs["my_job"]
+
+	require.Len(t, j.JobClusters, 2)
+	assert.Equal(t, "default", j.JobClusters[0].JobClusterKey)
+	assert.Equal(t, 2, j.JobClusters[0].NewCluster.NumWorkers)
+	assert.Equal(t, "large", j.JobClusters[1].JobClusterKey)
+	assert.Equal(t, 2, j.JobClusters[1].NewCluster.NumWorkers)
+}
+
+func TestComplexVariablesFromFlag(t *testing.T) {
+	b := loadComplexVariables(t, "staging",
+		"num_workers=8",
+		"photon=true",
+		`parameters=["--full-refresh"]`,
+	)
+	j := b.Config.Resources.Jobs["my_job"]
+
+	assert.Equal(t, 8, j.JobClusters[0].NewCluster.NumWorkers)
+	assert.True(t, j.JobClusters[0].NewCluster.EnableElasticDisk)
+	assert.Equal(t, []string{"--full-refresh"}, j.Tasks[0].SparkPythonTask.Parameters)
+}
+
+func TestComplexVariablesFromProcessEnvVar(t *testing.T) {
+	t.Setenv("BUNDLE_VAR_tags", `{"team": "ops"}`)
+	b := loadComplexVariables(t, "staging")
+	j := b.Config.Resources.Jobs["my_job"]
+
+	assert.Equal(t, map[string]string{"team": "ops"}, j.Tags)
+}
+
+func TestComplexVariablesInvalidFlagValue(t *testing.T) {
+	b := load(t, "./variables/complex")
+	err := b.Config.InitializeVariables([]string{"num_workers=many"})
+	assert.ErrorContains(t, err, "failed to assign many to num_workers: unable to parse value as number")
+
+	err = b.Config.InitializeVariables([]string{`num_workers="8"`})
+	assert.ErrorContains(t, err, `failed to assign "8" to num_workers: expected a value of type number but got 8`)
+}
@@ -92,3 +92,34 @@ func TestVariablesEnvironmentsBlockOverrideWithUndefinedVariables(t *testing.T)
 		)))
 	assert.ErrorContains(t, err, "variable c is not defined but is assigned a value")
 }
+
+func TestVariablesWithLookup(t *testing.T) {
+	b := load(t, "./variables/lookup")
+	assert.Equal(t, "shared-autoscaling", b.Config.Variables["cluster_id"].Lookup.Cluster)
+	assert.Equal(t, "Shared Warehouse", b.Config.Variables["warehouse_id"].Lookup.Warehouse)
+}
+
+func TestVariablesWithLookupOverriddenByProcessEnvVars(t *testing.T) {
+	t.Setenv("BUNDLE_VAR_cluster_id", "1234-567890-abcdefgh")
+	t.Setenv("BUNDLE_VAR_warehouse_id", "abcdef1234567890")
+	b := load(t, "./variables/lookup")
+	err := bundle.Apply(context.Background(), b, bundle.Seq(
+		mutator.SetVariables(),
+		interpolation.Interpolate(
+			interpolation.IncludeLookupsInPath(variable.VariableReferencePrefix),
+		)))
+	require.NoError(t, err)
+	assert.Equal(t, "1234-567890-abcdefgh", b.Config.Resources.Jobs["my_job"].Tasks[0].ExistingClusterId)
+}
+
+func TestVariablesWithLookupAssignedByEnvironment(t *testing.T) {
+	b := load(t, "./variables/lookup")
+	err := bundle.Apply(context.Background(), b, bundle.Seq(
+		mutator.SelectEnvironment("fixed"),
+		mutator.SetVariables(),
+		interpolation.Interpolate(
+			interpolation.IncludeLookupsInPath(variable.VariableReferencePrefix),
+		)))
+	require.NoError(t, err)
+	assert.Equal(t, "1234-567890-abcdefgh", b.Config.Resources.Jobs["my_job"].Tasks[0].ExistingClusterId)
+}
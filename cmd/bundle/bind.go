@@ -0,0 +1,64 @@
+package bundle
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/phases"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/flags"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+var bindCmd = &cobra.Command{
+	Use:   "bind KEY ID",
+	Short: "Bind a resource in the bundle to an existing remote resource",
+	Long: `Bind a resource in the bundle to an existing remote resource.
+
+The next deployment updates the remote resource with the bundle configuration
+instead of creating a new one.`,
+
+	Args:    cobra.ExactArgs(2),
+	PreRunE: ConfigureBundleWithVariables,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+		b := bundle.Get(ctx)
+
+		// If `--force` is specified, force acquisition of the deployment lock.
+		b.Config.Bundle.Lock.Force = forceBind
+
+		// If `--auto-approve`` is specified, we skip confirmation checks
+		b.AutoApprove = autoApproveBind
+
+		// we require auto-approve for non tty terminals since interactive consent
+		// is not possible
+		if !term.IsTerminal(int(os.Stderr.Fd())) && !autoApproveBind {
+			return fmt.Errorf("please specify --auto-approve to skip interactive confirmation checks for non tty consoles")
+		}
+
+		// Check auto-approve is selected for json logging
+		logger, ok := cmdio.FromContext(ctx)
+		if !ok {
+			return fmt.Errorf("progress logger not found")
+		}
+		if logger.Mode == flags.ModeJson && !autoApproveBind {
+			return fmt.Errorf("please specify --auto-approve since selected logging format is json")
+		}
+
+		return bundle.Apply(ctx, b, bundle.Seq(
+			phases.Initialize(),
+			phases.Bind(args[0], args[1]),
+		))
+	},
+}
+
+var autoApproveBind bool
+var forceBind bool
+
+func init() {
+	AddCommand(bindCmd)
+	bindCmd.Flags().BoolVar(&autoApproveBind, "auto-approve", false, "Skip interactive approval for binding the resource")
+	bindCmd.Flags().BoolVar(&forceBind, "force", false, "Force acquisition of deployment lock.")
+}
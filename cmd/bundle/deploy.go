@@ -2,6 +2,7 @@ package bundle
 
 import (
 	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config/mutator"
 	"github.com/databricks/cli/bundle/phases"
 	"github.com/spf13/cobra"
 )
@@ -17,6 +18,22 @@ var deployCmd = &cobra.Command{
 		// If `--force` is specified, force acquisition of the deployment lock.
 		b.Config.Bundle.Lock.Force = forceDeploy
 
+		// If `--resource` is specified, limit the deployment to the selected resources.
+		b.TargetResources = resourcesDeploy
+		b.IncludeDependencies = includeDependenciesDeploy
+
+		// If `--auto-approve` is specified, we skip confirmation checks for
+		// changes that delete or recreate resources.
+		b.AutoApprove = autoApproveDeploy
+
+		// If `--strict` is specified, fail on unknown fields in the configuration.
+		if strictDeploy {
+			err := bundle.Apply(cmd.Context(), b, mutator.ValidateUnknownFields())
+			if err != nil {
+				return err
+			}
+		}
+
 		return bundle.Apply(cmd.Context(), b, bundle.Seq(
 			phases.Initialize(),
 			phases.Build(),
@@ -26,8 +43,16 @@ var deployCmd = &cobra.Command{
 }
 
 var forceDeploy bool
+var resourcesDeploy []string
+var includeDependenciesDeploy bool
+var strictDeploy bool
+var autoApproveDeploy bool
 
 func init() {
 	AddCommand(deployCmd)
 	deployCmd.Flags().BoolVar(&forceDeploy, "force", false, "Force acquisition of deployment lock.")
+	deployCmd.Flags().StringArrayVar(&resourcesDeploy, "resource", nil, "Limit the deployment to the resource with this key (can be repeated).")
+	deployCmd.Flags().BoolVar(&includeDependenciesDeploy, "include-dependencies", false, "Also include resources that reference the selected resources.")
+	deployCmd.Flags().BoolVar(&autoApproveDeploy, "auto-approve", false, "Skip interactive approvals for deleting or recreating resources.")
+	deployCmd.Flags().BoolVar(&strictDeploy, "strict", false, "Fail if the configuration contains unknown fields.")
 }
@@ -24,6 +24,10 @@ var destroyCmd = &cobra.Command{
 		// If `--force` is specified, force acquisition of the deployment lock.
 		b.Config.Bundle.Lock.Force = forceDestroy
 
+		// If `--resource` is specified, limit the destruction to the selected resources.
+		b.TargetResources = resourcesDestroy
+		b.IncludeDependencies = includeDependenciesDestroy
+
 		// If `--auto-approve`` is specified, we skip confirmation checks
 		b.AutoApprove = autoApprove
 
@@ -52,9 +56,13 @@ var destroyCmd = &cobra.Command{
 
 var autoApprove bool
 var forceDestroy bool
+var resourcesDestroy []string
+var includeDependenciesDestroy bool
 
 func init() {
 	AddCommand(destroyCmd)
 	destroyCmd.Flags().BoolVar(&autoApprove, "auto-approve", false, "Skip interactive approvals for deleting resources and files")
 	destroyCmd.Flags().BoolVar(&forceDestroy, "force", false, "Force acquisition of deployment lock.")
+	destroyCmd.Flags().StringArrayVar(&resourcesDestroy, "resource", nil, "Limit the destruction to the resource with this key (can be repeated).")
+	destroyCmd.Flags().BoolVar(&includeDependenciesDestroy, "include-dependencies", false, "Also include resources that reference the selected resources.")
 }
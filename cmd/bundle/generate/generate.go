@@ -0,0 +1,56 @@
+package generate
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/spf13/cobra"
+
+	parent "github.com/databricks/cli/cmd/bundle"
+)
+
+var generateCmd = &cobra.Command{
+	Use:   "generate",
+	Short: "Generate bundle configuration for existing workspace resources",
+}
+
+func AddCommand(cmd *cobra.Command) {
+	generateCmd.AddCommand(cmd)
+}
+
+var key string
+var sourceDir string
+var configDir string
+var force bool
+
+// writeConfig writes the generated configuration for the resource with the
+// specified key to the configuration directory of the bundle.
+func writeConfig(cmd *cobra.Command, b *bundle.Bundle, kind string, buf []byte) error {
+	path := filepath.Join(b.Config.Path, configDir, fmt.Sprintf("%s.%s.yml", key, kind))
+	if _, err := os.Stat(path); err == nil && !force {
+		return fmt.Errorf("%s already exists; use --force to overwrite", path)
+	}
+
+	err := os.MkdirAll(filepath.Dir(path), 0755)
+	if err != nil {
+		return err
+	}
+	err = os.WriteFile(path, buf, 0644)
+	if err != nil {
+		return err
+	}
+
+	cmdio.LogString(cmd.Context(), fmt.Sprintf("%s configuration successfully saved to %s", kind, path))
+	return nil
+}
+
+func init() {
+	generateCmd.PersistentFlags().StringVar(&key, "key", "", `Resource key to use in the configuration (defaults to the resource name).`)
+	generateCmd.PersistentFlags().StringVar(&sourceDir, "source-dir", "src", `Directory relative to the bundle root to download notebooks and files to.`)
+	generateCmd.PersistentFlags().StringVar(&configDir, "config-dir", "resources", `Directory relative to the bundle root to write the configuration to.`)
+	generateCmd.PersistentFlags().BoolVar(&force, "force", false, `Overwrite existing files.`)
+	parent.AddCommand(generateCmd)
+}
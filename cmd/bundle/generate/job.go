@@ -0,0 +1,71 @@
+package generate
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/generate"
+	bundleCmd "github.com/databricks/cli/cmd/bundle"
+	"github.com/spf13/cobra"
+)
+
+var generateJobCmd = &cobra.Command{
+	Use:   "job JOB_ID",
+	Short: "Generate bundle configuration for an existing job",
+
+	Args:    cobra.ExactArgs(1),
+	PreRunE: bundleCmd.ConfigureBundleWithVariables,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+		b := bundle.Get(ctx)
+		w := b.WorkspaceClient()
+
+		jobId, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid job ID %s: %w", args[0], err)
+		}
+
+		job, err := w.Jobs.GetByJobId(ctx, jobId)
+		if err != nil {
+			return err
+		}
+		if job.Settings == nil {
+			return fmt.Errorf("job %d has no settings", jobId)
+		}
+
+		if key == "" {
+			key = generate.ResourceKey(job.Settings.Name)
+		}
+		if _, ok := b.Config.Resources.Jobs[key]; ok {
+			return fmt.Errorf("job %s is already defined in this bundle; use --key to specify a different key", key)
+		}
+
+		downloader := generate.NewDownloader(
+			w,
+			filepath.Join(b.Config.Path, sourceDir),
+			filepath.Join(b.Config.Path, configDir),
+		)
+		err = downloader.MarkJobForDownload(ctx, job.Settings)
+		if err != nil {
+			return err
+		}
+
+		buf, err := generate.JobConfig(key, job.Settings)
+		if err != nil {
+			return err
+		}
+
+		err = downloader.FlushToDisk(ctx, force)
+		if err != nil {
+			return err
+		}
+
+		return writeConfig(cmd, b, "job", buf)
+	},
+}
+
+func init() {
+	AddCommand(generateJobCmd)
+}
@@ -0,0 +1,65 @@
+package generate
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/generate"
+	bundleCmd "github.com/databricks/cli/cmd/bundle"
+	"github.com/spf13/cobra"
+)
+
+var generatePipelineCmd = &cobra.Command{
+	Use:   "pipeline PIPELINE_ID",
+	Short: "Generate bundle configuration for an existing pipeline",
+
+	Args:    cobra.ExactArgs(1),
+	PreRunE: bundleCmd.ConfigureBundleWithVariables,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+		b := bundle.Get(ctx)
+		w := b.WorkspaceClient()
+
+		pipeline, err := w.Pipelines.GetByPipelineId(ctx, args[0])
+		if err != nil {
+			return err
+		}
+		if pipeline.Spec == nil {
+			return fmt.Errorf("pipeline %s has no specification", args[0])
+		}
+
+		if key == "" {
+			key = generate.ResourceKey(pipeline.Spec.Name)
+		}
+		if _, ok := b.Config.Resources.Pipelines[key]; ok {
+			return fmt.Errorf("pipeline %s is already defined in this bundle; use --key to specify a different key", key)
+		}
+
+		downloader := generate.NewDownloader(
+			w,
+			filepath.Join(b.Config.Path, sourceDir),
+			filepath.Join(b.Config.Path, configDir),
+		)
+		err = downloader.MarkPipelineForDownload(ctx, pipeline.Spec)
+		if err != nil {
+			return err
+		}
+
+		buf, err := generate.PipelineConfig(key, pipeline.Spec)
+		if err != nil {
+			return err
+		}
+
+		err = downloader.FlushToDisk(ctx, force)
+		if err != nil {
+			return err
+		}
+
+		return writeConfig(cmd, b, "pipeline", buf)
+	},
+}
+
+func init() {
+	AddCommand(generatePipelineCmd)
+}
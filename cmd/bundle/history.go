@@ -0,0 +1,45 @@
+package bundle
+
+import (
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/deploy/history"
+	"github.com/databricks/cli/bundle/phases"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/filer"
+	"github.com/spf13/cobra"
+)
+
+const historyTemplate = `{{header "Timestamp"}}	{{header "Goal"}}	{{header "Environment"}}	{{header "User"}}	{{header "Branch"}}	{{header "Commit"}}	{{header "CLI version"}}	{{header "Resources changed"}}
+{{range .Records}}{{pretty_date .Timestamp}}	{{.Goal}}	{{.Environment}}	{{.User}}	{{.Git.Branch}}	{{.Git.Commit}}	{{.CliVersion}}	{{len .Resources}}
+{{end}}`
+
+var historyCmd = &cobra.Command{
+	Use:   "history",
+	Short: "List past deployments of the bundle",
+
+	PreRunE: ConfigureBundleWithVariables,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+		b := bundle.Get(ctx)
+		err := bundle.Apply(ctx, b, phases.Initialize())
+		if err != nil {
+			return err
+		}
+
+		f, err := filer.NewWorkspaceFilesClient(b.WorkspaceClient(), b.Config.Workspace.StatePath)
+		if err != nil {
+			return err
+		}
+
+		h, err := history.Load(ctx, f)
+		if err != nil {
+			return err
+		}
+
+		return cmdio.RenderWithTemplate(ctx, h, historyTemplate)
+	},
+}
+
+func init() {
+	AddCommand(historyCmd)
+}
@@ -0,0 +1,44 @@
+package bundle
+
+import (
+	"github.com/databricks/cli/bundle/template"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/spf13/cobra"
+)
+
+var initCmd = &cobra.Command{
+	Use:   "init [TEMPLATE_PATH]",
+	Short: "Initialize a new bundle from a template",
+	Long: `Initialize a new bundle from a template.
+
+TEMPLATE_PATH is a directory with a template. If it is not specified,
+the built-in default template is used.
+
+A template declares its input parameters in a JSON schema file named
+` + template.SchemaFileName + ` in its root directory. File contents
+and paths in the template are rendered with Go's text/template package.`,
+
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+
+		var templatePath string
+		if len(args) > 0 {
+			templatePath = args[0]
+		}
+
+		// Prompt for parameters without a value only if the values
+		// are not read from a file and the user can be prompted.
+		prompt := initConfigFile == "" && cmdio.IsInteractive(ctx) && cmdio.IsInTTY(ctx)
+		return template.Materialize(ctx, templatePath, initConfigFile, initOutputDir, prompt)
+	},
+}
+
+var initConfigFile string
+var initOutputDir string
+
+func init() {
+	AddCommand(initCmd)
+	initCmd.Flags().StringVar(&initConfigFile, "config-file", "", "JSON file with values for the input parameters of the template.")
+	initCmd.Flags().StringVar(&initOutputDir, "output-dir", ".", "Directory to write the initialized bundle to.")
+}
@@ -0,0 +1,26 @@
+package bundle
+
+import (
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/phases"
+	"github.com/spf13/cobra"
+)
+
+var planCmd = &cobra.Command{
+	Use:   "plan",
+	Short: "Show the changes that deploying the bundle would make",
+
+	PreRunE: ConfigureBundleWithVariables,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		b := bundle.Get(cmd.Context())
+		return bundle.Apply(cmd.Context(), b, bundle.Seq(
+			phases.Initialize(),
+			phases.Build(),
+			phases.Plan(),
+		))
+	},
+}
+
+func init() {
+	AddCommand(planCmd)
+}
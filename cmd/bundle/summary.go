@@ -0,0 +1,77 @@
+package bundle
+
+import (
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/deploy/terraform"
+	"github.com/databricks/cli/bundle/phases"
+	"github.com/databricks/cli/bundle/summary"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/spf13/cobra"
+)
+
+const summaryTemplate = `Name: {{.Bundle.Name}}
+{{- if .Bundle.Environment}}
+Environment: {{.Bundle.Environment}}
+{{- end}}
+{{- if .Bundle.Mode}}
+Mode: {{.Bundle.Mode}}
+{{- end}}
+Workspace:
+  Host: {{.Workspace.Host}}
+{{- if .Workspace.User}}
+  User: {{.Workspace.User}}
+{{- end}}
+  Root path: {{.Workspace.RootPath}}
+  Files path: {{.Workspace.FilesPath}}
+  Artifacts path: {{.Workspace.ArtifactPath}}
+  State path: {{.Workspace.StatePath}}
+Resources:
+{{- range .Resources}}
+  {{.Kind}}.{{.Key}}:
+    Name: {{.Name}}
+    ID: {{if .ID}}{{.ID}}{{else}}{{yellow "(not deployed)"}}{{end}}
+{{- if .URL}}
+    URL: {{.URL}}
+{{- end}}
+{{- if .Permissions}}
+    Permissions:
+{{- range .Permissions}}
+      - {{.Level}}: {{.UserName}}{{.ServicePrincipalName}}{{.GroupName}}
+{{- end}}
+{{- end}}
+{{- if .Grants}}
+    Grants:
+{{- range .Grants}}
+      - {{.Principal}}: {{join .Privileges ", "}}
+{{- end}}
+{{- end}}
+{{- end}}
+`
+
+var summaryCmd = &cobra.Command{
+	Use:   "summary",
+	Short: "Describe the resources in a bundle and where they are deployed",
+
+	PreRunE: ConfigureBundleWithVariables,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+		b := bundle.Get(ctx)
+		err := bundle.Apply(ctx, b, bundle.Seq(
+			phases.Initialize(),
+			terraform.Interpolate(),
+			terraform.Write(),
+			terraform.StatePull(),
+			terraform.Load(),
+		))
+		if err != nil {
+			return err
+		}
+
+		s := summary.New(&b.Config, b.WorkspaceClient().Config.Host)
+		return cmdio.RenderWithTemplate(ctx, s, summaryTemplate)
+	},
+}
+
+func init() {
+	AddCommand(summaryCmd)
+}
@@ -0,0 +1,37 @@
+package bundle
+
+import (
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/phases"
+	"github.com/spf13/cobra"
+)
+
+var unbindCmd = &cobra.Command{
+	Use:   "unbind KEY",
+	Short: "Unbind a resource in the bundle from its remote resource",
+	Long: `Unbind a resource in the bundle from its remote resource.
+
+The remote resource is not deleted. The next deployment creates a new one.`,
+
+	Args:    cobra.ExactArgs(1),
+	PreRunE: ConfigureBundleWithVariables,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+		b := bundle.Get(ctx)
+
+		// If `--force` is specified, force acquisition of the deployment lock.
+		b.Config.Bundle.Lock.Force = forceUnbind
+
+		return bundle.Apply(ctx, b, bundle.Seq(
+			phases.Initialize(),
+			phases.Unbind(args[0]),
+		))
+	},
+}
+
+var forceUnbind bool
+
+func init() {
+	AddCommand(unbindCmd)
+	unbindCmd.Flags().BoolVar(&forceUnbind, "force", false, "Force acquisition of deployment lock.")
+}
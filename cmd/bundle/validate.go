@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 
 	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config/mutator"
 	"github.com/databricks/cli/bundle/phases"
 	"github.com/spf13/cobra"
 )
@@ -16,7 +17,10 @@ var validateCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		b := bundle.Get(cmd.Context())
 
-		err := bundle.Apply(cmd.Context(), b, phases.Initialize())
+		err := bundle.Apply(cmd.Context(), b, bundle.Seq(
+			mutator.ValidateUnknownFields(),
+			phases.Initialize(),
+		))
 		if err != nil {
 			return err
 		}
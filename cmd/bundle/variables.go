@@ -19,5 +19,5 @@ func ConfigureBundleWithVariables(cmd *cobra.Command, args []string) error {
 }
 
 func AddVariableFlag(cmd *cobra.Command) {
-	cmd.PersistentFlags().StringSliceVar(&variables, "var", []string{}, `set values for variables defined in bundle config. Example: --var="foo=bar"`)
+	cmd.PersistentFlags().StringArrayVar(&variables, "var", []string{}, `set values for variables defined in bundle config. Example: --var="foo=bar". Values of variables with a complex type are parsed as JSON. Multiple string variables can be set with a single flag, for example --var="foo=bar,baz=qux".`)
 }
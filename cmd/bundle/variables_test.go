@@ -0,0 +1,45 @@
+package bundle
+
+import (
+	"testing"
+
+	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/cli/bundle/config/variable"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVariableFlagWithComplexValues(t *testing.T) {
+	t.Cleanup(func() { variables = nil })
+
+	cmd := &cobra.Command{}
+	AddVariableFlag(cmd)
+	err := cmd.ParseFlags([]string{
+		`--var=ids=[1,2]`,
+		`--var=tags={"a":1,"b":2}`,
+		`--var=name=foo,bar`,
+		`--var=a=1,b=2`,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{`ids=[1,2]`, `tags={"a":1,"b":2}`, `name=foo,bar`, `a=1,b=2`}, variables)
+
+	r := config.Root{
+		Variables: map[string]*variable.Variable{
+			"ids":  {Type: variable.VariableTypeList},
+			"tags": {Type: variable.VariableTypeMap},
+			"name": {},
+			"a":    {},
+			"b":    {},
+		},
+	}
+	err = r.InitializeVariables(variables)
+	require.NoError(t, err)
+	assert.Equal(t, []any{float64(1), float64(2)}, r.Variables["ids"].Value)
+	assert.Equal(t, map[string]any{"a": float64(1), "b": float64(2)}, r.Variables["tags"].Value)
+	assert.Equal(t, "foo,bar", r.Variables["name"].Value)
+
+	// Comma separated assignments of string variables are still supported.
+	assert.Equal(t, "1", r.Variables["a"].Value)
+	assert.Equal(t, "2", r.Variables["b"].Value)
+}
@@ -29,6 +29,7 @@ require (
 	golang.org/x/term v0.9.0
 	golang.org/x/text v0.10.0
 	gopkg.in/ini.v1 v1.67.0 // Apache 2.0
+	gopkg.in/yaml.v3 v3.0.1 // MIT + Apache 2.0
 )
 
 require (
@@ -60,5 +61,4 @@ require (
 	google.golang.org/grpc v1.56.1 // indirect
 	google.golang.org/protobuf v1.31.0 // indirect
 	gopkg.in/yaml.v2 v2.4.0 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
@@ -0,0 +1,17 @@
+package testutil
+
+import (
+	"context"
+	"io"
+	"strings"
+
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/flags"
+)
+
+// Context returns a context with command I/O that is not interactive,
+// reads no input, and discards all output.
+func Context() context.Context {
+	cmdIO := cmdio.NewIO(flags.OutputText, strings.NewReader(""), io.Discard, io.Discard, "")
+	return cmdio.InContext(context.Background(), cmdIO)
+}
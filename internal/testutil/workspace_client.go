@@ -0,0 +1,41 @@
+package testutil
+
+import (
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/compute"
+	"github.com/databricks/databricks-sdk-go/service/sql"
+	"github.com/databricks/databricks-sdk-go/service/workspace"
+)
+
+// WorkspaceServices holds the implementations of the services
+// that a workspace client returned by [WorkspaceClient] uses.
+type WorkspaceServices struct {
+	Clusters        compute.ClustersService
+	ClusterPolicies compute.ClusterPoliciesService
+	InstancePools   compute.InstancePoolsService
+	Warehouses      sql.WarehousesService
+	Workspace       workspace.WorkspaceService
+}
+
+// WorkspaceClient returns a workspace client that calls the specified
+// service implementations instead of the API. Services that are not
+// specified are not set and must not be used.
+func WorkspaceClient(s WorkspaceServices) *databricks.WorkspaceClient {
+	w := &databricks.WorkspaceClient{}
+	if s.Clusters != nil {
+		w.Clusters = compute.NewClusters(nil).WithImpl(s.Clusters)
+	}
+	if s.ClusterPolicies != nil {
+		w.ClusterPolicies = compute.NewClusterPolicies(nil).WithImpl(s.ClusterPolicies)
+	}
+	if s.InstancePools != nil {
+		w.InstancePools = compute.NewInstancePools(nil).WithImpl(s.InstancePools)
+	}
+	if s.Warehouses != nil {
+		w.Warehouses = sql.NewWarehouses(nil).WithImpl(s.Warehouses)
+	}
+	if s.Workspace != nil {
+		w.Workspace = workspace.NewWorkspace(nil).WithImpl(s.Workspace)
+	}
+	return w
+}
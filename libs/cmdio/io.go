@@ -50,6 +50,18 @@ func IsInteractive(ctx context.Context) bool {
 	return c.interactive
 }
 
+// IsPromptSupported returns true if the user can be prompted for input.
+// This requires an interactive cmdIO whose input reader is a terminal.
+// Unlike [IsInteractive], it returns false if the context has no cmdIO,
+// for example when bundles are loaded outside of the CLI.
+func IsPromptSupported(ctx context.Context) bool {
+	c, ok := ctx.Value(cmdIOKey).(*cmdIO)
+	if !ok {
+		return false
+	}
+	return c.interactive && IsTTY(c.in)
+}
+
 // IsTTY detects if io.Writer is a terminal.
 func IsTTY(w any) bool {
 	f, ok := w.(*os.File)
@@ -205,6 +217,16 @@ func Prompt(ctx context.Context) *promptui.Prompt {
 	}
 }
 
+// StderrPrompt returns a prompt that writes to the error stream instead of
+// the output stream, such that prompting doesn't mix with command output.
+func StderrPrompt(ctx context.Context) *promptui.Prompt {
+	c := fromContext(ctx)
+	return &promptui.Prompt{
+		Stdin:  io.NopCloser(c.in),
+		Stdout: nopWriteCloser{c.err},
+	}
+}
+
 func (c *cmdIO) Spinner(ctx context.Context) chan string {
 	var sp *spinner.Spinner
 	if c.interactive {
@@ -0,0 +1,29 @@
+package exec
+
+import (
+	"context"
+	"fmt"
+	osexec "os/exec"
+	"runtime"
+	"strings"
+
+	"github.com/databricks/cli/libs/log"
+)
+
+// Run runs a command in a shell in the specified directory.
+// The output of the command is included in the error if it fails.
+func Run(ctx context.Context, dir, command string) error {
+	var cmd *osexec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = osexec.CommandContext(ctx, "cmd", "/C", command)
+	} else {
+		cmd = osexec.CommandContext(ctx, "sh", "-c", command)
+	}
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("%s failed: %w\n%s", command, err, strings.TrimSpace(string(out)))
+	}
+	log.Debugf(ctx, "Output of %s: %s", command, out)
+	return nil
+}
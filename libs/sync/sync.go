@@ -163,6 +163,42 @@ func (s *Sync) RunOnce(ctx context.Context) error {
 	return nil
 }
 
+// Plan returns the changes that an incremental synchronization with the specified
+// options would make, without making them or updating the snapshot.
+//
+// Unlike [New], it doesn't verify or create the remote path, so it can be used
+// to preview changes without touching the workspace.
+func Plan(ctx context.Context, opts SyncOptions) (*EventChanges, error) {
+	fileSet, err := git.NewFileSet(opts.LocalPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.Host == "" && opts.WorkspaceClient != nil {
+		opts.Host = opts.WorkspaceClient.Config.Host
+	}
+	if opts.Host == "" {
+		return nil, fmt.Errorf("failed to resolve host for snapshot")
+	}
+
+	snapshot, err := loadOrNewSnapshot(ctx, &opts)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load sync snapshot: %w", err)
+	}
+
+	all, err := fileSet.All()
+	if err != nil {
+		return nil, err
+	}
+
+	change, err := snapshot.diff(ctx, all)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EventChanges{Put: change.put, Delete: change.delete}, nil
+}
+
 func (s *Sync) DestroySnapshot(ctx context.Context) error {
 	return s.snapshot.Destroy(ctx)
 }
@@ -0,0 +1,35 @@
+package sync
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/databricks/cli/libs/testfile"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlan(t *testing.T) {
+	ctx := context.Background()
+	projectDir := t.TempDir()
+	snapshotDir := t.TempDir()
+	testfile.CreateFile(t, filepath.Join(projectDir, "hello.txt"))
+	testfile.CreateFile(t, filepath.Join(projectDir, "world.txt"))
+
+	// No workspace client is configured, so this must not make any API calls.
+	changes, err := Plan(ctx, SyncOptions{
+		LocalPath:        projectDir,
+		RemotePath:       "/Users/jane@doe.com/project",
+		SnapshotBasePath: snapshotDir,
+		Host:             "https://myworkspace.cloud.databricks.com",
+	})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"hello.txt", "world.txt"}, changes.Put)
+	assert.Empty(t, changes.Delete)
+
+	// The planned changes are not recorded in the snapshot.
+	_, err = os.Stat(filepath.Join(snapshotDir, syncSnapshotDirName, GetFileName("https://myworkspace.cloud.databricks.com", "/Users/jane@doe.com/project")))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
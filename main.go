@@ -6,6 +6,7 @@ import (
 	_ "github.com/databricks/cli/cmd/auth"
 	_ "github.com/databricks/cli/cmd/bundle"
 	_ "github.com/databricks/cli/cmd/bundle/debug"
+	_ "github.com/databricks/cli/cmd/bundle/generate"
 	_ "github.com/databricks/cli/cmd/configure"
 	_ "github.com/databricks/cli/cmd/fs"
 	"github.com/databricks/cli/cmd/root"